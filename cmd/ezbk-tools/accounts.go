@@ -0,0 +1,151 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pranavagiligar/ezbookkeeping_tools/internal/api"
+	"github.com/pranavagiligar/ezbookkeeping_tools/internal/config"
+	"github.com/pranavagiligar/ezbookkeeping_tools/internal/report"
+)
+
+// fetchAccounts logs in and returns the full account list.
+func fetchAccounts(cfg *config.Config) ([]api.Account, error) {
+	fmt.Printf("Attempting login to %s as user: %s\n", cfg.BaseURL, cfg.LoginName)
+	client := api.NewClient(cfg.BaseURL, cfg.LoginName, cfg.Password, cfg.Debug)
+	if err := client.Login(); err != nil {
+		return nil, fmt.Errorf("failed to get authentication token: %w", err)
+	}
+	fmt.Println("✅ Successfully retrieved token.")
+
+	accounts, err := client.FetchAccountList()
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch account list: %w", err)
+	}
+	return accounts, nil
+}
+
+// splitAssetsAndLiabilities separates an account list the same way the
+// original tool did: by the IsAsset/IsLiability flags on each account.
+func splitAssetsAndLiabilities(accounts []api.Account) (assets, liabilities []api.Account) {
+	for _, account := range accounts {
+		if account.IsAsset {
+			assets = append(assets, account)
+		} else if account.IsLiability {
+			liabilities = append(liabilities, account)
+		}
+	}
+	return assets, liabilities
+}
+
+// recordSnapshot writes a snapshot batch for accounts and returns the
+// snapshot history recorded over the last year, for use in trend charts.
+func recordSnapshot(cfg *config.Config, accounts []api.Account, now time.Time) ([]report.Snapshot, error) {
+	store, err := report.OpenSnapshotStore(cfg.SnapshotDBPath, cfg.SnapshotDriver, cfg.SnapshotDSN)
+	if err != nil {
+		return nil, fmt.Errorf("snapshot store unavailable: %w", err)
+	}
+	defer store.Close()
+
+	if err := store.WriteBatch(report.SnapshotsFromAccounts(accounts, now)); err != nil {
+		return nil, fmt.Errorf("failed to write balance snapshot: %w", err)
+	}
+	fmt.Printf("📈 Recorded balance snapshot for %d accounts\n", len(accounts))
+
+	history, err := store.Query(now.AddDate(-1, 0, -1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load snapshot history for trend charts: %w", err)
+	}
+	return history, nil
+}
+
+var accountsExportCommand = &Command{
+	Name:        "accounts export",
+	Description: "Write the account list as CSV/HTML/OFX",
+	Setup: func(args []string) (*Context, error) {
+		cfg := config.Default()
+		fs := newSharedFlags("accounts export", cfg)
+		fs.StringVar(&cfg.OutputFormat, "format", cfg.OutputFormat, "Report format(s) to produce: ofx|csv|html|all")
+		fs.StringVar(&cfg.OFXOutPath, "ofx-out", cfg.OFXOutPath, "Output path for the OFX statement (used when -format is ofx or all)")
+		fs.BoolVar(&cfg.Print, "print", false, "Print CSV data to the console")
+		addFXFlags(fs, cfg)
+		if err := loadSharedConfig(fs, cfg, args); err != nil {
+			return nil, err
+		}
+		return &Context{Flags: fs, Cfg: cfg}, nil
+	},
+	CheckFlags: requireAPIConfig,
+	Do: func(ctx *Context) error {
+		accounts, err := fetchAccounts(ctx.Cfg)
+		if err != nil {
+			return err
+		}
+		assets, liabilities := splitAssetsAndLiabilities(accounts)
+
+		if wantsFormat(ctx.Cfg.OutputFormat, "csv") {
+			if err := report.ExportToCSV("assets.csv", assets, ctx.Cfg.Print); err != nil {
+				fmt.Printf("❌ %v\n", err)
+			}
+			if err := report.ExportToCSV("liabilities.csv", liabilities, ctx.Cfg.Print); err != nil {
+				fmt.Printf("❌ %v\n", err)
+			}
+		}
+
+		if wantsFormat(ctx.Cfg.OutputFormat, "ofx") {
+			if err := report.ExportToOFX(ctx.Cfg.OFXOutPath, accounts); err != nil {
+				fmt.Printf("❌ %v\n", err)
+			}
+		}
+
+		if wantsFormat(ctx.Cfg.OutputFormat, "html") {
+			fxProvider, err := buildFXProvider(ctx.Cfg)
+			if err != nil {
+				fmt.Printf("⚠️ Could not set up FX rate provider, consolidated net worth will be omitted: %v\n", err)
+			}
+			html := report.GenerateHTMLReport(assets, liabilities, report.ReportOptions{
+				Now:          time.Now(),
+				BaseCurrency: ctx.Cfg.BaseCurrency,
+				FXProvider:   fxProvider,
+			})
+			if err := report.WriteHTML("accounts.html", html); err != nil {
+				fmt.Printf("❌ %v\n", err)
+			} else {
+				fmt.Println("📝 Successfully wrote HTML report to accounts.html")
+			}
+		}
+		return nil
+	},
+}
+
+var accountsSnapshotCommand = &Command{
+	Name:        "accounts snapshot",
+	Description: "Record a balance snapshot",
+	Setup: func(args []string) (*Context, error) {
+		cfg := config.Default()
+		fs := newSharedFlags("accounts snapshot", cfg)
+		fs.StringVar(&cfg.SnapshotDBPath, "snapshot-db", cfg.SnapshotDBPath, "Path to the sqlite snapshot database (used when -snapshot-dsn is not set)")
+		fs.StringVar(&cfg.SnapshotDSN, "snapshot-dsn", "", "DSN for a generic SQL snapshot store (e.g. Postgres); overrides -snapshot-db")
+		fs.StringVar(&cfg.SnapshotDriver, "snapshot-driver", cfg.SnapshotDriver, "database/sql driver name to use with -snapshot-dsn")
+		if err := loadSharedConfig(fs, cfg, args); err != nil {
+			return nil, err
+		}
+		return &Context{Flags: fs, Cfg: cfg}, nil
+	},
+	CheckFlags: requireAPIConfig,
+	Do: func(ctx *Context) error {
+		accounts, err := fetchAccounts(ctx.Cfg)
+		if err != nil {
+			return err
+		}
+		_, err = recordSnapshot(ctx.Cfg, accounts, time.Now())
+		return err
+	},
+}
+
+// wantsFormat reports whether the requested output format (as passed via
+// -format) includes the given report kind. "all" matches every kind.
+func wantsFormat(requested, kind string) bool {
+	requested = strings.ToLower(strings.TrimSpace(requested))
+	return requested == "all" || requested == strings.ToLower(kind)
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/pranavagiligar/ezbookkeeping_tools/internal/config"
+)
+
+var configInitCommand = &Command{
+	Name:        "config init",
+	Description: "Interactively scaffold a .env file",
+	Setup: func(args []string) (*Context, error) {
+		cfg := config.Default()
+		fs := newSharedFlags("config init", cfg)
+		if err := fs.Parse(args); err != nil {
+			return nil, err
+		}
+		return &Context{Flags: fs, Cfg: cfg}, nil
+	},
+	Do: func(ctx *Context) error {
+		if _, err := os.Stat(ctx.Cfg.File); err == nil {
+			return fmt.Errorf("%s already exists; remove it first if you want to regenerate it", ctx.Cfg.File)
+		}
+
+		reader := bufio.NewReader(os.Stdin)
+		prompt := func(label, def string) string {
+			if def != "" {
+				fmt.Printf("%s [%s]: ", label, def)
+			} else {
+				fmt.Printf("%s: ", label)
+			}
+			line, _ := reader.ReadString('\n')
+			line = strings.TrimSpace(line)
+			if line == "" {
+				return def
+			}
+			return line
+		}
+
+		baseURL := prompt("ezbookkeeping base URL", "https://")
+		loginName := prompt("Login name", "")
+		password := prompt("Password", "")
+		emailTo := prompt("Report recipient email (optional)", "")
+		smtpHost := prompt("SMTP host (optional)", "")
+
+		var b strings.Builder
+		fmt.Fprintf(&b, "BASE_URL=%s\n", baseURL)
+		fmt.Fprintf(&b, "LOGIN_NAME=%s\n", loginName)
+		fmt.Fprintf(&b, "PASSWORD=%s\n", password)
+		if emailTo != "" {
+			fmt.Fprintf(&b, "EMAIL_TO=%s\n", emailTo)
+		}
+		if smtpHost != "" {
+			fmt.Fprintf(&b, "SMTP_HOST=%s\n", smtpHost)
+		}
+
+		if err := os.WriteFile(ctx.Cfg.File, []byte(b.String()), 0600); err != nil {
+			return fmt.Errorf("error writing %s: %w", ctx.Cfg.File, err)
+		}
+		fmt.Printf("✅ Wrote %s\n", ctx.Cfg.File)
+		return nil
+	},
+}
@@ -0,0 +1,112 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"os"
+
+	"filippo.io/age"
+
+	"github.com/pranavagiligar/ezbookkeeping_tools/internal/config"
+)
+
+var configSealCommand = &Command{
+	Name:        "config seal",
+	Description: "Encrypt an existing .env file to .env.age with age",
+	Setup:       sealUnsealSetup("config seal"),
+	Do: func(ctx *Context) error {
+		recipientStr := ctx.Flags.Lookup("recipient").Value.String()
+		if recipientStr == "" {
+			recipientStr = os.Getenv("EZBK_RECIPIENT")
+		}
+		if recipientStr == "" {
+			return fmt.Errorf("no age recipient supplied; pass -recipient or set EZBK_RECIPIENT")
+		}
+		recipient, err := age.ParseX25519Recipient(recipientStr)
+		if err != nil {
+			return fmt.Errorf("invalid age recipient: %w", err)
+		}
+
+		plaintext, err := os.ReadFile(ctx.Cfg.File)
+		if err != nil {
+			return fmt.Errorf("error reading %s: %w", ctx.Cfg.File, err)
+		}
+
+		var sealed bytes.Buffer
+		w, err := age.Encrypt(&sealed, recipient)
+		if err != nil {
+			return fmt.Errorf("error starting age encryption: %w", err)
+		}
+		if _, err := w.Write(plaintext); err != nil {
+			return fmt.Errorf("error encrypting %s: %w", ctx.Cfg.File, err)
+		}
+		if err := w.Close(); err != nil {
+			return fmt.Errorf("error finalizing encryption of %s: %w", ctx.Cfg.File, err)
+		}
+
+		outPath := ctx.Cfg.File + ".age"
+		if err := os.WriteFile(outPath, sealed.Bytes(), 0600); err != nil {
+			return fmt.Errorf("error writing %s: %w", outPath, err)
+		}
+		if err := os.Remove(ctx.Cfg.File); err != nil {
+			fmt.Printf("⚠️ Wrote %s but could not remove the plaintext %s: %v\n", outPath, ctx.Cfg.File, err)
+			return nil
+		}
+		fmt.Printf("✅ Sealed %s into %s\n", ctx.Cfg.File, outPath)
+		return nil
+	},
+}
+
+var configUnsealCommand = &Command{
+	Name:        "config unseal",
+	Description: "Decrypt a .env.age file back to plaintext .env",
+	Setup:       sealUnsealSetup("config unseal"),
+	Do: func(ctx *Context) error {
+		identityPath := ctx.Cfg.IdentityPath
+		if identityPath == "" {
+			identityPath = os.Getenv("EZBK_IDENTITY")
+		}
+		if identityPath == "" {
+			return fmt.Errorf("no age identity supplied; pass -identity or set EZBK_IDENTITY")
+		}
+
+		sealedPath := ctx.Cfg.File
+		if sealedPath == ".env" {
+			sealedPath = ".env.age"
+		}
+		plaintext, err := config.DecryptAgeFile(sealedPath, identityPath)
+		if err != nil {
+			return err
+		}
+
+		outPath := trimAgeSuffix(sealedPath)
+		if err := os.WriteFile(outPath, plaintext, 0600); err != nil {
+			return fmt.Errorf("error writing %s: %w", outPath, err)
+		}
+		fmt.Printf("✅ Unsealed %s into %s — remember this is now plaintext on disk\n", sealedPath, outPath)
+		return nil
+	},
+}
+
+func sealUnsealSetup(name string) func(args []string) (*Context, error) {
+	return func(args []string) (*Context, error) {
+		cfg := config.Default()
+		fs := flag.NewFlagSet(name, flag.ExitOnError)
+		fs.StringVar(&cfg.File, "config", cfg.File, "Path to the .env file to seal/unseal")
+		fs.StringVar(&cfg.IdentityPath, "identity", "", "age identity file (for unseal); falls back to EZBK_IDENTITY")
+		fs.String("recipient", "", "age public recipient to encrypt for (for seal); falls back to EZBK_RECIPIENT")
+		if err := fs.Parse(args); err != nil {
+			return nil, err
+		}
+		return &Context{Flags: fs, Cfg: cfg}, nil
+	}
+}
+
+func trimAgeSuffix(path string) string {
+	const suffix = ".age"
+	if len(path) > len(suffix) && path[len(path)-len(suffix):] == suffix {
+		return path[:len(path)-len(suffix)]
+	}
+	return path + ".plain"
+}
@@ -0,0 +1,31 @@
+package main
+
+import (
+	"flag"
+	"time"
+
+	"github.com/pranavagiligar/ezbookkeeping_tools/internal/config"
+	"github.com/pranavagiligar/ezbookkeeping_tools/internal/fxrates"
+)
+
+// addFXFlags wires the flags shared by every subcommand that can emit a
+// consolidated net worth panel.
+func addFXFlags(fs *flag.FlagSet, cfg *config.Config) {
+	fs.StringVar(&cfg.BaseCurrency, "base-currency", "", "Consolidate all accounts into this currency (e.g. USD) using an FX rate provider")
+	fs.StringVar(&cfg.FXFile, "fx-file", "", "Static YAML/JSON exchange rate file; when unset, live ECB reference rates are used")
+	fs.StringVar(&cfg.FXCachePath, "fx-cache", cfg.FXCachePath, "Disk cache path for the live ECB rate feed")
+}
+
+// buildFXProvider returns nil (meaning "no consolidation") when
+// BaseCurrency isn't set, a StaticProvider when -fx-file is given, or an
+// ECBProvider otherwise.
+func buildFXProvider(cfg *config.Config) (fxrates.FXProvider, error) {
+	if cfg.BaseCurrency == "" {
+		return nil, nil
+	}
+	if cfg.FXFile != "" {
+		return fxrates.LoadStaticProvider(cfg.FXFile)
+	}
+	ttl := time.Duration(cfg.FXCacheTTLMins) * time.Minute
+	return fxrates.NewECBProvider(cfg.FXCachePath, ttl), nil
+}
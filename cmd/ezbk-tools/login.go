@@ -0,0 +1,31 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/pranavagiligar/ezbookkeeping_tools/internal/api"
+	"github.com/pranavagiligar/ezbookkeeping_tools/internal/config"
+)
+
+var loginCommand = &Command{
+	Name:        "login",
+	Description: "Verify API credentials and print a token",
+	Setup: func(args []string) (*Context, error) {
+		cfg := config.Default()
+		fs := newSharedFlags("login", cfg)
+		if err := loadSharedConfig(fs, cfg, args); err != nil {
+			return nil, err
+		}
+		return &Context{Flags: fs, Cfg: cfg}, nil
+	},
+	CheckFlags: requireAPIConfig,
+	Do: func(ctx *Context) error {
+		fmt.Printf("Attempting login to %s as user: %s\n", ctx.Cfg.BaseURL, ctx.Cfg.LoginName)
+		client := api.NewClient(ctx.Cfg.BaseURL, ctx.Cfg.LoginName, ctx.Cfg.Password, ctx.Cfg.Debug)
+		if err := client.Login(); err != nil {
+			return fmt.Errorf("failed to get authentication token: %w", err)
+		}
+		fmt.Println("✅ Successfully retrieved token.")
+		return nil
+	},
+}
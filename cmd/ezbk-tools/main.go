@@ -0,0 +1,98 @@
+// Command ezbk-tools talks to an ezbookkeeping server to export account
+// data, record balance snapshots, and email a summary report.
+//
+// Usage:
+//
+//	ezbk-tools <command> [subcommand] [flags]
+//
+// Commands:
+//
+//	login                 verify API credentials and print a token
+//	accounts export       write the account list as CSV/HTML/OFX
+//	accounts snapshot      record a balance snapshot
+//	report send           export and email the report
+//	config init           interactively scaffold a .env file
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+// Command is one dispatchable action. Each Command owns its own FlagSet
+// so it can expose action-specific flags (e.g. `accounts export
+// --format ofx`) on top of the shared ones bound in newSharedFlags.
+type Command struct {
+	Name        string
+	Description string
+	Setup       func(args []string) (*Context, error)
+	CheckFlags  func(ctx *Context) error
+	Do          func(ctx *Context) error
+}
+
+// commands is keyed by a (possibly two-word) path such as "login" or
+// "accounts export".
+var commands = map[string]*Command{
+	"login":             loginCommand,
+	"accounts export":   accountsExportCommand,
+	"accounts snapshot": accountsSnapshotCommand,
+	"report send":       reportSendCommand,
+	"config init":       configInitCommand,
+	"config seal":       configSealCommand,
+	"config unseal":     configUnsealCommand,
+}
+
+func main() {
+	name, args := resolveCommandName(os.Args[1:])
+	cmd, ok := commands[name]
+	if !ok {
+		printUsage()
+		os.Exit(1)
+	}
+
+	ctx, err := cmd.Setup(args)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "🚨 %s: %v\n", name, err)
+		os.Exit(1)
+	}
+
+	if cmd.CheckFlags != nil {
+		if err := cmd.CheckFlags(ctx); err != nil {
+			fmt.Fprintf(os.Stderr, "🚨 %s: %v\n", name, err)
+			os.Exit(1)
+		}
+	}
+
+	if err := cmd.Do(ctx); err != nil {
+		fmt.Fprintf(os.Stderr, "🚨 %s: %v\n", name, err)
+		os.Exit(1)
+	}
+}
+
+// resolveCommandName matches the longest registered command name against
+// the leading words of args ("accounts export foo" -> "accounts export",
+// remaining args ["foo"]).
+func resolveCommandName(args []string) (string, []string) {
+	if len(args) >= 2 {
+		if two := args[0] + " " + args[1]; commands[two] != nil {
+			return two, args[2:]
+		}
+	}
+	if len(args) >= 1 {
+		return args[0], args[1:]
+	}
+	return "", nil
+}
+
+func printUsage() {
+	fmt.Println("Usage: ezbk-tools <command> [subcommand] [flags]")
+	fmt.Println()
+	fmt.Println("Commands:")
+	fmt.Println("  login                  verify API credentials and print a token")
+	fmt.Println("  accounts export        write the account list as CSV/HTML/OFX")
+	fmt.Println("  accounts snapshot      record a balance snapshot")
+	fmt.Println("  report send            export and email the report")
+	fmt.Println("  config init            interactively scaffold a .env file")
+	fmt.Println("  config seal            encrypt an existing .env to .env.age")
+	fmt.Println("  config unseal          decrypt a .env.age back to plaintext")
+}
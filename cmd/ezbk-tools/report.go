@@ -0,0 +1,110 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pranavagiligar/ezbookkeeping_tools/internal/config"
+	"github.com/pranavagiligar/ezbookkeeping_tools/internal/mail"
+	"github.com/pranavagiligar/ezbookkeeping_tools/internal/report"
+)
+
+var reportSendCommand = &Command{
+	Name:        "report send",
+	Description: "Export the account report and email it",
+	Setup: func(args []string) (*Context, error) {
+		cfg := config.Default()
+		fs := newSharedFlags("report send", cfg)
+		fs.StringVar(&cfg.OutputFormat, "format", cfg.OutputFormat, "Report format(s) to produce alongside the email: ofx|csv|html|all")
+		fs.StringVar(&cfg.OFXOutPath, "ofx-out", cfg.OFXOutPath, "Output path for the OFX statement (used when -format is ofx or all)")
+		fs.BoolVar(&cfg.Print, "print", false, "Print CSV data to the console")
+		fs.StringVar(&cfg.SnapshotDBPath, "snapshot-db", cfg.SnapshotDBPath, "Path to the sqlite snapshot database (used when -snapshot-dsn is not set)")
+		fs.StringVar(&cfg.SnapshotDSN, "snapshot-dsn", "", "DSN for a generic SQL snapshot store (e.g. Postgres); overrides -snapshot-db")
+		fs.StringVar(&cfg.SnapshotDriver, "snapshot-driver", cfg.SnapshotDriver, "database/sql driver name to use with -snapshot-dsn")
+		fs.BoolVar(&cfg.SnapshotOnly, "snapshot-only", false, "Only record a balance snapshot; skip export and email")
+		fs.StringVar(&cfg.EmailRecipient, "email-to", "", "Recipient email address for the report.")
+		fs.StringVar(&cfg.SMTPHost, "smtp-host", "", "SMTP server host.")
+		fs.IntVar(&cfg.SMTPPort, "smtp-port", cfg.SMTPPort, "SMTP server port (default 587).")
+		fs.StringVar(&cfg.SMTPUsername, "smtp-user", "", "SMTP username.")
+		fs.StringVar(&cfg.SMTPPassword, "smtp-pass", "", "SMTP password.")
+		fs.StringVar(&cfg.SMTPSender, "smtp-from", "", "Sender email address (must match SMTP user for some servers).")
+		addFXFlags(fs, cfg)
+		if err := loadSharedConfig(fs, cfg, args); err != nil {
+			return nil, err
+		}
+		return &Context{Flags: fs, Cfg: cfg}, nil
+	},
+	CheckFlags: requireAPIConfig,
+	Do: func(ctx *Context) error {
+		cfg := ctx.Cfg
+		accounts, err := fetchAccounts(cfg)
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		history, err := recordSnapshot(cfg, accounts, now)
+		if err != nil {
+			fmt.Printf("⚠️ %v\n", err)
+		}
+
+		if cfg.SnapshotOnly {
+			return nil
+		}
+
+		assets, liabilities := splitAssetsAndLiabilities(accounts)
+
+		if wantsFormat(cfg.OutputFormat, "csv") {
+			if err := report.ExportToCSV("assets.csv", assets, cfg.Print); err != nil {
+				fmt.Printf("❌ %v\n", err)
+			}
+			if err := report.ExportToCSV("liabilities.csv", liabilities, cfg.Print); err != nil {
+				fmt.Printf("❌ %v\n", err)
+			}
+		}
+
+		if wantsFormat(cfg.OutputFormat, "ofx") {
+			if err := report.ExportToOFX(cfg.OFXOutPath, accounts); err != nil {
+				fmt.Printf("❌ %v\n", err)
+			}
+		}
+
+		fxProvider, err := buildFXProvider(cfg)
+		if err != nil {
+			fmt.Printf("⚠️ Could not set up FX rate provider, consolidated net worth will be omitted: %v\n", err)
+		}
+		htmlContent := report.GenerateHTMLReport(assets, liabilities, report.ReportOptions{
+			History:      history,
+			Now:          now,
+			BaseCurrency: cfg.BaseCurrency,
+			FXProvider:   fxProvider,
+		})
+		if wantsFormat(cfg.OutputFormat, "html") {
+			if err := report.WriteHTML("accounts.html", htmlContent); err != nil {
+				fmt.Printf("❌ %v\n", err)
+			}
+		}
+
+		if cfg.EmailRecipient != "" && cfg.SMTPHost != "" && cfg.SMTPUsername != "" {
+			ofxAttachment, err := report.MakeOFX(accounts)
+			if err != nil {
+				fmt.Printf("⚠️ Could not build OFX attachment, sending email without it: %v\n", err)
+			}
+			emailCfg := mail.Config{
+				Recipient: cfg.EmailRecipient,
+				Host:      cfg.SMTPHost,
+				Port:      cfg.SMTPPort,
+				Username:  cfg.SMTPUsername,
+				Password:  cfg.SMTPPassword,
+				Sender:    cfg.SMTPSender,
+			}
+			if err := mail.SendReportEmail(emailCfg, htmlContent, ofxAttachment); err != nil {
+				return fmt.Errorf("failed to send email: %w", err)
+			}
+			fmt.Printf("✅ Email report successfully sent to %s\n", cfg.EmailRecipient)
+		} else if cfg.EmailRecipient != "" {
+			fmt.Println("⚠️ Email flags missing. Not sending email. Use -smtp-host, -smtp-user, and -email-to.")
+		}
+		return nil
+	},
+}
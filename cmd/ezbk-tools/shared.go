@@ -0,0 +1,44 @@
+package main
+
+import (
+	"flag"
+
+	"github.com/pranavagiligar/ezbookkeeping_tools/internal/config"
+)
+
+// Context carries the flags and loaded config shared by every
+// subcommand's Do function.
+type Context struct {
+	Flags *flag.FlagSet
+	Cfg   *config.Config
+}
+
+// newSharedFlags builds a FlagSet pre-bound to the --url/--user/--pass/
+// --config flags every subcommand accepts, plus the debug flag. Each
+// subcommand's Setup adds its own action-specific flags on top before
+// calling Parse.
+func newSharedFlags(name string, cfg *config.Config) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ExitOnError)
+	fs.StringVar(&cfg.BaseURL, "url", "", "The base URL of the API (e.g., https://domain_name)")
+	fs.StringVar(&cfg.LoginName, "user", "", "The login name for API authorization")
+	fs.StringVar(&cfg.Password, "pass", "", "The password for API authorization")
+	fs.StringVar(&cfg.File, "config", cfg.File, "Path to configuration file (default .env; may be .env.age or .enc.yaml)")
+	fs.StringVar(&cfg.IdentityPath, "identity", "", "age identity file used to decrypt an .env.age -config; falls back to EZBK_IDENTITY")
+	fs.BoolVar(&cfg.Debug, "debug", false, "Enable detailed HTTP request/response logging")
+	return fs
+}
+
+// loadSharedConfig parses args against fs and then fills in anything
+// still unset from the configured .env file.
+func loadSharedConfig(fs *flag.FlagSet, cfg *config.Config, args []string) error {
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	return config.Load(cfg)
+}
+
+// requireAPIConfig is the CheckFlags used by every subcommand that talks
+// to the ezbookkeeping API.
+func requireAPIConfig(ctx *Context) error {
+	return ctx.Cfg.Validate()
+}
@@ -0,0 +1,208 @@
+// Package api is a minimal client for the subset of the ezbookkeeping
+// HTTP API this tool needs: authorization and account listing. A future
+// transactions endpoint is expected to land here too.
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httputil"
+)
+
+type AccountCategory int
+
+const (
+	Cash AccountCategory = iota + 1
+	CheckingAccount
+	CreditCard
+	VirtualAccount
+	DebtAccount
+	Receivables
+	InvestmentAccount
+	SavingsAccount
+	CertificateOfDeposit
+)
+
+// String returns the human-readable name for the AccountCategory.
+func (a AccountCategory) String() string {
+	switch a {
+	case Cash:
+		return "Cash"
+	case CheckingAccount:
+		return "Checking Account"
+	case CreditCard:
+		return "Credit Card"
+	case VirtualAccount:
+		return "Virtual Account"
+	case DebtAccount:
+		return "Debt Account"
+	case Receivables:
+		return "Receivables"
+	case InvestmentAccount:
+		return "Investment Account"
+	case SavingsAccount:
+		return "Savings Account"
+	case CertificateOfDeposit:
+		return "Certificate of Deposit"
+	default:
+		return "Unknown"
+	}
+}
+
+type AuthResponse struct {
+	Result struct {
+		Token string `json:"token"`
+	} `json:"result"`
+}
+
+type Account struct {
+	ID                      string  `json:"id"`
+	Name                    string  `json:"name"`
+	ParentID                string  `json:"parentId"`
+	Category                int     `json:"category"`
+	Type                    int     `json:"type"`
+	Icon                    string  `json:"icon"`
+	Color                   string  `json:"color"`
+	Currency                string  `json:"currency"`
+	Balance                 float64 `json:"balance"` // This holds the balance in minor units (e.g., cents)
+	Comment                 string  `json:"comment"`
+	DisplayOrder            int     `json:"displayOrder"`
+	IsAsset                 bool    `json:"isAsset"`
+	Hidden                  bool    `json:"hidden"`
+	CreditCardStatementDate int     `json:"creditCardStatementDate"`
+	IsLiability             bool    `json:"isLiability"`
+}
+
+type AccountListResponse struct {
+	Result  []Account `json:"result"`
+	Success bool      `json:"success"`
+}
+
+// Client is a thin, stateful wrapper around the ezbookkeeping HTTP API.
+// Login must be called once before any other method.
+type Client struct {
+	BaseURL   string
+	LoginName string
+	Password  string
+	Debug     bool
+
+	httpClient *http.Client
+	token      string
+}
+
+// NewClient builds a Client for the given base URL and credentials.
+func NewClient(baseURL, loginName, password string, debug bool) *Client {
+	return &Client{
+		BaseURL:    baseURL,
+		LoginName:  loginName,
+		Password:   password,
+		Debug:      debug,
+		httpClient: &http.Client{},
+	}
+}
+
+// Login exchanges LoginName/Password for a bearer token and caches it for
+// subsequent requests.
+func (c *Client) Login() error {
+	authData := map[string]string{
+		"loginName": c.LoginName,
+		"password":  c.Password,
+	}
+	jsonData, _ := json.Marshal(authData)
+	req, err := http.NewRequest("POST", c.BaseURL+"/api/authorize.json", bytes.NewBuffer(jsonData))
+	if err != nil {
+		return fmt.Errorf("error creating auth request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if c.Debug {
+		dumpRequest(req, "Auth Request")
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("error executing auth request: %w", err)
+	}
+	defer resp.Body.Close()
+	if c.Debug {
+		dumpResponseHeaders(resp, "Auth Response")
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("authorization failed with status code: %d, response body: %s", resp.StatusCode, string(bodyBytes))
+	}
+
+	var authResp AuthResponse
+	if err := json.NewDecoder(resp.Body).Decode(&authResp); err != nil {
+		return fmt.Errorf("error decoding auth response: %w", err)
+	}
+
+	c.token = authResp.Result.Token
+	return nil
+}
+
+// FetchAccountList returns every account (including hidden ones) visible
+// to the logged-in user.
+func (c *Client) FetchAccountList() ([]Account, error) {
+	req, err := http.NewRequest("GET", c.BaseURL+"/api/v1/accounts/list.json?visible_only=false", nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating list request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+c.token)
+
+	if c.Debug {
+		dumpRequest(req, "List Request")
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error executing list request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if c.Debug {
+		dumpResponseHeaders(resp, "List Response")
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("account list retrieval failed with status code: %d, response body: %s", resp.StatusCode, string(bodyBytes))
+	}
+
+	bodyBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading list response body: %w", err)
+	}
+
+	var listResp AccountListResponse
+	if err := json.Unmarshal(bodyBytes, &listResp); err != nil {
+		return nil, fmt.Errorf("error decoding account list response: %w", err)
+	}
+
+	if !listResp.Success {
+		return nil, fmt.Errorf("account list API returned success: false")
+	}
+
+	return listResp.Result, nil
+}
+
+func dumpRequest(req *http.Request, title string) {
+	dump, err := httputil.DumpRequestOut(req, true)
+	if err != nil {
+		fmt.Printf("Error dumping %s: %v\n", title, err)
+		return
+	}
+	fmt.Printf("\n--- DEBUG: %s Details ---\n%s\n--- END %s ---\n", title, dump, title)
+}
+
+func dumpResponseHeaders(resp *http.Response, title string) {
+	fmt.Printf("\n--- DEBUG: %s Headers ---\n", title)
+	fmt.Printf("Status: %s\n", resp.Status)
+	for key, values := range resp.Header {
+		fmt.Printf("%s: %s\n", key, values)
+	}
+	fmt.Printf("--- END %s Headers ---\n", title)
+}
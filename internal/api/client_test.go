@@ -0,0 +1,67 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func newTestServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/authorize.json", func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]string
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("decoding auth request: %v", err)
+		}
+		if body["loginName"] != "tester" || body["password"] != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		json.NewEncoder(w).Encode(AuthResponse{Result: struct {
+			Token string `json:"token"`
+		}{Token: "test-token"}})
+	})
+	mux.HandleFunc("/api/v1/accounts/list.json", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer test-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		json.NewEncoder(w).Encode(AccountListResponse{
+			Success: true,
+			Result: []Account{
+				{ID: "1", Name: "Checking", Currency: "USD", Balance: 12345, IsAsset: true},
+			},
+		})
+	})
+	return httptest.NewServer(mux)
+}
+
+func TestClientLoginAndFetchAccountList(t *testing.T) {
+	srv := newTestServer(t)
+	defer srv.Close()
+
+	client := NewClient(srv.URL, "tester", "secret", false)
+	if err := client.Login(); err != nil {
+		t.Fatalf("Login() error = %v", err)
+	}
+
+	accounts, err := client.FetchAccountList()
+	if err != nil {
+		t.Fatalf("FetchAccountList() error = %v", err)
+	}
+	if len(accounts) != 1 || accounts[0].ID != "1" {
+		t.Fatalf("unexpected accounts: %+v", accounts)
+	}
+}
+
+func TestClientLoginFailure(t *testing.T) {
+	srv := newTestServer(t)
+	defer srv.Close()
+
+	client := NewClient(srv.URL, "tester", "wrong-password", false)
+	if err := client.Login(); err == nil {
+		t.Fatal("expected Login() to fail with an invalid password")
+	}
+}
@@ -0,0 +1,206 @@
+// Package config loads the shared configuration used by every
+// ezbk-tools subcommand: the ezbookkeeping API credentials, email
+// delivery settings, and the report/snapshot options. Values may come
+// from command-line flags, a plain .env file, or an encrypted .env.age
+// (age) / .enc.yaml (SOPS) file, with flags taking precedence.
+package config
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/joho/godotenv"
+
+	"github.com/pranavagiligar/ezbookkeeping_tools/internal/secretscan"
+)
+
+// Config holds every setting shared across subcommands. Subcommand flag
+// sets bind directly into the fields they care about; Load then fills in
+// anything still empty from the config file.
+type Config struct {
+	// API
+	BaseURL   string
+	LoginName string
+	Password  string
+	Debug     bool
+
+	// Email
+	EmailRecipient string
+	SMTPHost       string
+	SMTPPort       int
+	SMTPUsername   string
+	SMTPPassword   string
+	SMTPSender     string
+
+	// Report / snapshot output
+	OutputFormat   string
+	OFXOutPath     string
+	SnapshotDBPath string
+	SnapshotDSN    string
+	SnapshotDriver string
+	SnapshotOnly   bool
+	Print          bool
+
+	// Multi-currency consolidation
+	BaseCurrency   string
+	FXFile         string
+	FXCachePath    string
+	FXCacheTTLMins int
+
+	// Path to the config file backing this Config: a plain .env, an
+	// age-encrypted .env.age, or a SOPS-encrypted .enc.yaml.
+	File string
+	// IdentityPath is the age identity file used to decrypt File when it
+	// ends in .age. Falls back to the EZBK_IDENTITY environment variable.
+	IdentityPath string
+}
+
+// Default returns a Config populated with the same defaults the flat
+// main.go used to hard-code as flag defaults.
+func Default() *Config {
+	return &Config{
+		File:           ".env",
+		OutputFormat:   "all",
+		OFXOutPath:     "accounts.ofx",
+		SnapshotDBPath: "snapshots.db",
+		SnapshotDriver: "postgres",
+		SMTPPort:       587,
+		FXCachePath:    "ecb-rates-cache.json",
+		FXCacheTTLMins: 60,
+	}
+}
+
+// Load fills in any fields still at their zero value from cfg.File, if
+// it exists, then refuses to proceed if any of the resulting values look
+// like a secret that is committed to this repository. Encrypted files
+// (.env.age, .enc.yaml) are decrypted in memory; the plaintext never
+// touches disk.
+func Load(cfg *Config) error {
+	if _, err := os.Stat(cfg.File); err != nil {
+		return nil
+	}
+
+	fmt.Printf("📄 Loading configuration from %s\n", cfg.File)
+	env, err := readConfigFile(cfg)
+	if err != nil {
+		return err
+	}
+	applyEnv(cfg, env)
+
+	return guardAgainstCommittedSecrets(cfg)
+}
+
+// readConfigFile returns the key/value pairs in cfg.File, transparently
+// decrypting it first if its name marks it as an encrypted config.
+func readConfigFile(cfg *Config) (map[string]string, error) {
+	switch {
+	case strings.HasSuffix(cfg.File, ".age"):
+		plaintext, err := decryptAge(cfg.File, identityPath(cfg))
+		if err != nil {
+			return nil, fmt.Errorf("error decrypting %s: %w", cfg.File, err)
+		}
+		env, err := godotenv.Unmarshal(string(plaintext))
+		if err != nil {
+			return nil, fmt.Errorf("error parsing decrypted %s: %w", cfg.File, err)
+		}
+		return env, nil
+	case strings.HasSuffix(cfg.File, ".enc.yaml") || strings.HasSuffix(cfg.File, ".enc.yml"):
+		env, err := decryptSOPS(cfg.File)
+		if err != nil {
+			return nil, fmt.Errorf("error decrypting %s: %w", cfg.File, err)
+		}
+		return env, nil
+	default:
+		env, err := godotenv.Read(cfg.File)
+		if err != nil {
+			return nil, fmt.Errorf("error loading config file %s: %w", cfg.File, err)
+		}
+		return env, nil
+	}
+}
+
+func identityPath(cfg *Config) string {
+	if cfg.IdentityPath != "" {
+		return cfg.IdentityPath
+	}
+	return os.Getenv("EZBK_IDENTITY")
+}
+
+// applyEnv fills in any cfg field still at its zero value from env.
+func applyEnv(cfg *Config, env map[string]string) {
+	if cfg.BaseURL == "" {
+		cfg.BaseURL = env["BASE_URL"]
+	}
+	if cfg.LoginName == "" {
+		cfg.LoginName = env["LOGIN_NAME"]
+	}
+	if cfg.Password == "" {
+		cfg.Password = env["PASSWORD"]
+	}
+	if cfg.EmailRecipient == "" {
+		cfg.EmailRecipient = env["EMAIL_TO"]
+	}
+	if cfg.SMTPHost == "" {
+		cfg.SMTPHost = env["SMTP_HOST"]
+	}
+	if cfg.SMTPPort == 0 {
+		cfg.SMTPPort = envToInt(env, "SMTP_PORT", 587)
+	}
+	if cfg.SMTPUsername == "" {
+		cfg.SMTPUsername = env["SMTP_USER"]
+	}
+	if cfg.SMTPPassword == "" {
+		cfg.SMTPPassword = env["SMTP_PASS"]
+	}
+	if cfg.SMTPSender == "" {
+		cfg.SMTPSender = env["SMTP_FROM"]
+	}
+}
+
+// guardAgainstCommittedSecrets refuses to continue if any sensitive
+// config value was found inside a file tracked by the git repository at
+// os.Getwd().
+func guardAgainstCommittedSecrets(cfg *Config) error {
+	wd, err := os.Getwd()
+	if err != nil {
+		return nil
+	}
+
+	findings, err := secretscan.GuardCommittedSecrets(wd, map[string]string{
+		"Password":     cfg.Password,
+		"SMTPPassword": cfg.SMTPPassword,
+		"BaseURL":      cfg.BaseURL,
+	})
+	if err != nil || len(findings) == 0 {
+		return nil
+	}
+
+	var msgs []string
+	for _, f := range findings {
+		msgs = append(msgs, f.String())
+	}
+	return fmt.Errorf("refusing to run: secret(s) committed to this repository: %s", strings.Join(msgs, "; "))
+}
+
+// Validate checks that the fields required to talk to the ezbookkeeping
+// API are present.
+func (c *Config) Validate() error {
+	if c.BaseURL == "" || c.LoginName == "" || c.Password == "" {
+		return fmt.Errorf("missing required config: -url, -user, -pass (or BASE_URL/LOGIN_NAME/PASSWORD in %s)", c.File)
+	}
+	return nil
+}
+
+func envToInt(env map[string]string, key string, defaultVal int) int {
+	val := env[key]
+	if val == "" {
+		return defaultVal
+	}
+	num, err := strconv.Atoi(val)
+	if err != nil {
+		return defaultVal
+	}
+	return num
+}
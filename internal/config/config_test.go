@@ -0,0 +1,40 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadFillsInFromEnvFile(t *testing.T) {
+	dir := t.TempDir()
+	envPath := filepath.Join(dir, ".env")
+	contents := "BASE_URL=https://example.test\nLOGIN_NAME=tester\nPASSWORD=secret\n"
+	if err := os.WriteFile(envPath, []byte(contents), 0600); err != nil {
+		t.Fatalf("writing test .env: %v", err)
+	}
+
+	cfg := Default()
+	cfg.File = envPath
+	if err := Load(cfg); err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+
+	if cfg.BaseURL != "https://example.test" || cfg.LoginName != "tester" || cfg.Password != "secret" {
+		t.Fatalf("unexpected config after Load(): %+v", cfg)
+	}
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("Validate() error = %v", err)
+	}
+}
+
+func TestLoadMissingFileIsNotAnError(t *testing.T) {
+	cfg := Default()
+	cfg.File = filepath.Join(t.TempDir(), "does-not-exist.env")
+	if err := Load(cfg); err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if err := cfg.Validate(); err == nil {
+		t.Fatal("expected Validate() to fail when no credentials were ever supplied")
+	}
+}
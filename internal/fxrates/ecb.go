@@ -0,0 +1,189 @@
+package fxrates
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ecbFeedURL is the ECB daily reference-rates feed. Every rate in it is
+// quoted against EUR.
+const ecbFeedURL = "https://www.ecb.europa.eu/stats/eurofxref/eurofxref-daily.xml"
+
+type ecbEnvelope struct {
+	XMLName xml.Name `xml:"Envelope"`
+	Cube    struct {
+		Cube struct {
+			Time  string `xml:"time,attr"`
+			Rates []struct {
+				Currency string `xml:"currency,attr"`
+				Rate     string `xml:"rate,attr"`
+			} `xml:"Cube"`
+		} `xml:"Cube"`
+	} `xml:"Cube"`
+}
+
+// ecbCache is what gets persisted to CachePath between runs.
+type ecbCache struct {
+	FetchedAt time.Time          `json:"fetchedAt"`
+	AsOf      string             `json:"asOf"`
+	EURRates  map[string]float64 `json:"eurRates"`
+}
+
+// ECBProvider fetches EUR reference rates from the ECB and caches them to
+// disk for TTL so repeated runs (e.g. hourly cron) don't hammer the feed.
+type ECBProvider struct {
+	CachePath string
+	TTL       time.Duration
+
+	httpClient *http.Client
+	cache      *ecbCache
+}
+
+// NewECBProvider returns a provider that caches the ECB feed at
+// cachePath, refetching once the cached copy is older than ttl.
+func NewECBProvider(cachePath string, ttl time.Duration) *ECBProvider {
+	return &ECBProvider{
+		CachePath:  cachePath,
+		TTL:        ttl,
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// Rate returns the rate to convert one unit of base into quote, using
+// the most recently fetched ECB EUR cross rates. `at` is ignored: the
+// ECB feed only ever exposes the latest daily rates.
+func (p *ECBProvider) Rate(base, quote string, at time.Time) (float64, error) {
+	if err := p.ensureFresh(); err != nil {
+		return 0, err
+	}
+
+	base, quote = strings.ToUpper(base), strings.ToUpper(quote)
+	if base == quote {
+		return 1, nil
+	}
+
+	baseToEUR, err := p.eurRateOf(base)
+	if err != nil {
+		return 0, err
+	}
+	quoteToEUR, err := p.eurRateOf(quote)
+	if err != nil {
+		return 0, err
+	}
+	// baseToEUR is EUR-per-1-base (inverted below), so base -> quote is
+	// (quote units per EUR) / (base units per EUR).
+	return quoteToEUR / baseToEUR, nil
+}
+
+// eurRateOf returns how many units of currency equal one EUR.
+func (p *ECBProvider) eurRateOf(currency string) (float64, error) {
+	if currency == "EUR" {
+		return 1, nil
+	}
+	rate, ok := p.cache.EURRates[currency]
+	if !ok {
+		return 0, &ErrRateUnavailable{Base: "EUR", Quote: currency}
+	}
+	return rate, nil
+}
+
+// ensureFresh loads the on-disk cache if present and still within TTL;
+// otherwise it fetches a new copy from the ECB feed and writes it back.
+func (p *ECBProvider) ensureFresh() error {
+	if p.cache == nil {
+		if cached, err := readECBCache(p.CachePath); err == nil {
+			p.cache = cached
+		}
+	}
+	if p.cache != nil && time.Since(p.cache.FetchedAt) < p.TTL {
+		return nil
+	}
+
+	fresh, err := p.fetch()
+	if err != nil {
+		if p.cache != nil {
+			// Serve stale data rather than fail outright if the feed is
+			// unreachable but we have something on disk.
+			return nil
+		}
+		return err
+	}
+	p.cache = fresh
+	return writeECBCache(p.CachePath, fresh)
+}
+
+func (p *ECBProvider) fetch() (*ecbCache, error) {
+	resp, err := p.httpClient.Get(ecbFeedURL)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching ECB rate feed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("ECB rate feed returned status %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading ECB rate feed: %w", err)
+	}
+
+	var envelope ecbEnvelope
+	if err := xml.Unmarshal(body, &envelope); err != nil {
+		return nil, fmt.Errorf("error parsing ECB rate feed: %w", err)
+	}
+
+	rates := make(map[string]float64, len(envelope.Cube.Cube.Rates))
+	for _, r := range envelope.Cube.Cube.Rates {
+		rate, err := strconv.ParseFloat(r.Rate, 64)
+		if err != nil {
+			continue
+		}
+		rates[strings.ToUpper(r.Currency)] = rate
+	}
+
+	return &ecbCache{
+		FetchedAt: time.Now(),
+		AsOf:      envelope.Cube.Cube.Time,
+		EURRates:  rates,
+	}, nil
+}
+
+func readECBCache(path string) (*ecbCache, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var cache ecbCache
+	if err := json.Unmarshal(data, &cache); err != nil {
+		return nil, fmt.Errorf("error parsing cached ECB rates at %s: %w", path, err)
+	}
+	return &cache, nil
+}
+
+func writeECBCache(path string, cache *ecbCache) error {
+	data, err := json.Marshal(cache)
+	if err != nil {
+		return fmt.Errorf("error encoding ECB rate cache: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("error writing ECB rate cache to %s: %w", path, err)
+	}
+	return nil
+}
+
+// AsOf returns the "as of" timestamp reported by the ECB feed for the
+// currently cached rates, for inclusion in the audit trail.
+func (p *ECBProvider) AsOf() string {
+	if p.cache == nil {
+		return ""
+	}
+	return p.cache.AsOf
+}
@@ -0,0 +1,29 @@
+// Package fxrates converts account balances between currencies so a
+// multi-currency user can see one consolidated net worth. Rates come
+// from a pluggable FXProvider; two implementations are provided: a
+// static file (StaticProvider) and a live feed from the ECB reference
+// rates (ECBProvider).
+package fxrates
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// FXProvider resolves the exchange rate to convert one unit of base into
+// quote, as of the given time. Implementations that don't track history
+// may ignore `at` and always return their latest known rate.
+type FXProvider interface {
+	Rate(base, quote string, at time.Time) (float64, error)
+}
+
+// ErrRateUnavailable is wrapped into the error returned by a provider
+// when it has no rate for the requested currency pair.
+type ErrRateUnavailable struct {
+	Base, Quote string
+}
+
+func (e *ErrRateUnavailable) Error() string {
+	return fmt.Sprintf("no exchange rate available for %s -> %s", strings.ToUpper(e.Base), strings.ToUpper(e.Quote))
+}
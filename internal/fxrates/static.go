@@ -0,0 +1,76 @@
+package fxrates
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// StaticProvider serves exchange rates from an in-memory table loaded
+// once from a YAML or JSON file. The file format is a flat
+// currency-to-currency-to-rate table:
+//
+//	USD:
+//	  EUR: 0.92
+//	  INR: 83.1
+//	EUR:
+//	  USD: 1.087
+type StaticProvider struct {
+	rates map[string]map[string]float64
+}
+
+// LoadStaticProvider reads the rate table at path, using YAML or JSON
+// decoding based on its extension (.yaml/.yml vs anything else).
+func LoadStaticProvider(path string) (*StaticProvider, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading FX rate file %s: %w", path, err)
+	}
+
+	rates := make(map[string]map[string]float64)
+	if strings.HasSuffix(path, ".yaml") || strings.HasSuffix(path, ".yml") {
+		if err := yaml.Unmarshal(data, &rates); err != nil {
+			return nil, fmt.Errorf("error parsing FX rate file %s as YAML: %w", path, err)
+		}
+	} else {
+		if err := json.Unmarshal(data, &rates); err != nil {
+			return nil, fmt.Errorf("error parsing FX rate file %s as JSON: %w", path, err)
+		}
+	}
+
+	normalized := make(map[string]map[string]float64, len(rates))
+	for base, quotes := range rates {
+		inner := make(map[string]float64, len(quotes))
+		for quote, rate := range quotes {
+			inner[strings.ToUpper(quote)] = rate
+		}
+		normalized[strings.ToUpper(base)] = inner
+	}
+
+	return &StaticProvider{rates: normalized}, nil
+}
+
+// Rate looks up base -> quote directly, falling back to the inverse of
+// quote -> base when that's what the file contains. `at` is ignored: a
+// static file has no history.
+func (p *StaticProvider) Rate(base, quote string, at time.Time) (float64, error) {
+	base, quote = strings.ToUpper(base), strings.ToUpper(quote)
+	if base == quote {
+		return 1, nil
+	}
+	if quotes, ok := p.rates[base]; ok {
+		if rate, ok := quotes[quote]; ok {
+			return rate, nil
+		}
+	}
+	if quotes, ok := p.rates[quote]; ok {
+		if rate, ok := quotes[base]; ok && rate != 0 {
+			return 1 / rate, nil
+		}
+	}
+	return 0, &ErrRateUnavailable{Base: base, Quote: quote}
+}
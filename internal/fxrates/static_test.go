@@ -0,0 +1,35 @@
+package fxrates
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestStaticProviderDirectAndInverseRates(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "rates.json")
+	if err := os.WriteFile(path, []byte(`{"USD":{"EUR":0.9},"EUR":{"INR":90}}`), 0600); err != nil {
+		t.Fatalf("writing rate file: %v", err)
+	}
+
+	p, err := LoadStaticProvider(path)
+	if err != nil {
+		t.Fatalf("LoadStaticProvider() error = %v", err)
+	}
+
+	if rate, err := p.Rate("USD", "EUR", time.Now()); err != nil || rate != 0.9 {
+		t.Errorf("Rate(USD, EUR) = %v, %v; want 0.9, nil", rate, err)
+	}
+	if rate, err := p.Rate("EUR", "USD", time.Now()); err != nil {
+		t.Fatalf("Rate(EUR, USD) error = %v", err)
+	} else if rate < 1.11 || rate > 1.112 {
+		t.Errorf("Rate(EUR, USD) = %v, want ~1.111 (inverse of 0.9)", rate)
+	}
+	if rate, err := p.Rate("USD", "USD", time.Now()); err != nil || rate != 1 {
+		t.Errorf("Rate(USD, USD) = %v, %v; want 1, nil", rate, err)
+	}
+	if _, err := p.Rate("USD", "GBP", time.Now()); err == nil {
+		t.Error("expected Rate(USD, GBP) to fail when no rate is configured")
+	}
+}
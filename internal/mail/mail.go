@@ -0,0 +1,45 @@
+// Package mail sends the generated HTML report by email.
+package mail
+
+import (
+	"io"
+
+	"github.com/go-gomail/gomail"
+)
+
+// Config holds the SMTP settings needed to deliver a report email.
+type Config struct {
+	Recipient string
+	Host      string
+	Port      int
+	Username  string
+	Password  string
+	Sender    string
+}
+
+// SendReportEmail configures and sends the report email using gomail. When
+// ofxAttachment is non-empty, it is attached as accounts.ofx using
+// gomail's SetCopyFunc so the document is streamed straight from memory
+// into the MIME part without ever touching disk.
+func SendReportEmail(cfg Config, htmlBody string, ofxAttachment []byte) error {
+	sender := cfg.Sender
+	if sender == "" {
+		sender = cfg.Username // Default to using username as sender if not specified
+	}
+
+	m := gomail.NewMessage()
+	m.SetHeader("From", sender)
+	m.SetHeader("To", cfg.Recipient)
+	m.SetHeader("Subject", "Financial Account Balance Report")
+	m.SetBody("text/html", htmlBody)
+	if len(ofxAttachment) > 0 {
+		m.Attach("accounts.ofx", gomail.SetCopyFunc(func(w io.Writer) error {
+			_, err := w.Write(ofxAttachment)
+			return err
+		}))
+	}
+
+	d := gomail.NewDialer(cfg.Host, cfg.Port, cfg.Username, cfg.Password)
+
+	return d.DialAndSend(m)
+}
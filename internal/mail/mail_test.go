@@ -0,0 +1,145 @@
+package mail
+
+import (
+	"bufio"
+	"encoding/base64"
+	"net"
+	"strings"
+	"testing"
+	"time"
+)
+
+// fakeSMTPServer is a minimal SMTP server good enough to exercise the
+// Dialer's plaintext, no-auth path: it never advertises AUTH or STARTTLS,
+// so gomail sends MAIL/RCPT/DATA straight through. It records the DATA
+// payload of the last message it received.
+type fakeSMTPServer struct {
+	listener net.Listener
+	lastData chan string
+}
+
+func startFakeSMTPServer(t *testing.T) *fakeSMTPServer {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("error starting fake SMTP server: %v", err)
+	}
+	srv := &fakeSMTPServer{listener: ln, lastData: make(chan string, 1)}
+	go srv.serveOne(t)
+	return srv
+}
+
+func (s *fakeSMTPServer) addr() string {
+	return s.listener.Addr().String()
+}
+
+func (s *fakeSMTPServer) serveOne(t *testing.T) {
+	conn, err := s.listener.Accept()
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	reader := bufio.NewReader(conn)
+	reply := func(line string) {
+		conn.Write([]byte(line + "\r\n"))
+	}
+
+	reply("220 localhost ESMTP fake")
+	var data strings.Builder
+	inData := false
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return
+		}
+		line = strings.TrimRight(line, "\r\n")
+
+		if inData {
+			if line == "." {
+				inData = false
+				s.lastData <- data.String()
+				reply("250 OK")
+				continue
+			}
+			data.WriteString(line + "\n")
+			continue
+		}
+
+		switch {
+		case strings.HasPrefix(strings.ToUpper(line), "EHLO"), strings.HasPrefix(strings.ToUpper(line), "HELO"):
+			reply("250 localhost")
+		case strings.HasPrefix(strings.ToUpper(line), "MAIL FROM"), strings.HasPrefix(strings.ToUpper(line), "RCPT TO"):
+			reply("250 OK")
+		case strings.HasPrefix(strings.ToUpper(line), "DATA"):
+			inData = true
+			data.Reset()
+			reply("354 End data with <CR><LF>.<CR><LF>")
+		case strings.HasPrefix(strings.ToUpper(line), "QUIT"):
+			reply("221 Bye")
+			return
+		default:
+			reply("250 OK")
+		}
+	}
+}
+
+func dialerConfig(addr string) Config {
+	host, portStr, _ := net.SplitHostPort(addr)
+	var port int
+	for _, r := range portStr {
+		port = port*10 + int(r-'0')
+	}
+	return Config{
+		Recipient: "to@example.com",
+		Host:      host,
+		Port:      port,
+		Sender:    "from@example.com",
+	}
+}
+
+func TestSendReportEmailAttachesOFXWhenProvided(t *testing.T) {
+	srv := startFakeSMTPServer(t)
+	defer srv.listener.Close()
+
+	err := SendReportEmail(dialerConfig(srv.addr()), "<p>balances</p>", []byte("OFXPAYLOAD"))
+	if err != nil {
+		t.Fatalf("SendReportEmail() error = %v", err)
+	}
+
+	select {
+	case got := <-srv.lastData:
+		if !strings.Contains(got, "Subject: Financial Account Balance Report") {
+			t.Error("expected the subject header in the sent message")
+		}
+		if !strings.Contains(got, "accounts.ofx") {
+			t.Error("expected the OFX attachment filename in the sent message")
+		}
+		// Attachments are base64-encoded, so look for the encoded form of
+		// the payload rather than the raw bytes.
+		if !strings.Contains(got, base64.StdEncoding.EncodeToString([]byte("OFXPAYLOAD"))) {
+			t.Error("expected the base64-encoded OFX attachment content in the sent message")
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for the fake SMTP server to receive a message")
+	}
+}
+
+func TestSendReportEmailOmitsAttachmentWhenEmpty(t *testing.T) {
+	srv := startFakeSMTPServer(t)
+	defer srv.listener.Close()
+
+	err := SendReportEmail(dialerConfig(srv.addr()), "<p>balances</p>", nil)
+	if err != nil {
+		t.Fatalf("SendReportEmail() error = %v", err)
+	}
+
+	select {
+	case got := <-srv.lastData:
+		if strings.Contains(got, "accounts.ofx") {
+			t.Error("expected no OFX attachment when ofxAttachment is empty")
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for the fake SMTP server to receive a message")
+	}
+}
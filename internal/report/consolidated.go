@@ -0,0 +1,92 @@
+package report
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pranavagiligar/ezbookkeeping_tools/internal/api"
+	"github.com/pranavagiligar/ezbookkeeping_tools/internal/fxrates"
+)
+
+// generateConsolidatedPanel renders the "Consolidated Net Worth" panel:
+// every account's balance converted into baseCurrency, a per-currency
+// subtotal, and a grand total. Currencies with no available rate fall
+// back to a per-currency-only warning instead of being silently dropped
+// from the total. The rate table (and its as-of timestamp) is included
+// so the converted figures are auditable.
+func generateConsolidatedPanel(accounts []api.Account, baseCurrency string, provider fxrates.FXProvider, asOf time.Time) string {
+	if baseCurrency == "" || provider == nil {
+		return ""
+	}
+	baseCurrency = strings.ToUpper(baseCurrency)
+
+	type currencySubtotal struct {
+		currency    string
+		nativeTotal float64
+		converted   float64
+		rate        float64
+		rateErr     error
+	}
+
+	subtotalsByCurrency := make(map[string]*currencySubtotal)
+	var order []string
+	for _, acc := range accounts {
+		currency := strings.ToUpper(acc.Currency)
+		sub, ok := subtotalsByCurrency[currency]
+		if !ok {
+			sub = &currencySubtotal{currency: currency}
+			subtotalsByCurrency[currency] = sub
+			order = append(order, currency)
+		}
+		value := convertBalanceValue(acc.Balance, acc.Currency)
+		if acc.IsLiability {
+			value = -value
+		} else if !acc.IsAsset {
+			continue
+		}
+		sub.nativeTotal += value
+	}
+	sort.Strings(order)
+
+	var grandTotal float64
+	var missingRates []string
+	for _, currency := range order {
+		sub := subtotalsByCurrency[currency]
+		rate, err := provider.Rate(currency, baseCurrency, asOf)
+		sub.rate, sub.rateErr = rate, err
+		if err != nil {
+			missingRates = append(missingRates, currency)
+			continue
+		}
+		sub.converted = sub.nativeTotal * rate
+		grandTotal += sub.converted
+	}
+
+	var panel strings.Builder
+	panel.WriteString("<h2>Consolidated Net Worth</h2>")
+	if len(missingRates) > 0 {
+		panel.WriteString(fmt.Sprintf(
+			"<p class=\"negative\">⚠️ No exchange rate available for: %s. These currencies are shown per-currency only and excluded from the grand total.</p>",
+			strings.Join(missingRates, ", ")))
+	}
+
+	panel.WriteString("<table><thead><tr><th>Currency</th><th>Native Total</th><th>Rate to " + baseCurrency + "</th><th>Converted (" + baseCurrency + ")</th></tr></thead><tbody>")
+	for _, currency := range order {
+		sub := subtotalsByCurrency[currency]
+		if sub.rateErr != nil {
+			panel.WriteString(fmt.Sprintf("<tr><td>%s</td><td>%.2f</td><td colspan=\"2\">unavailable</td></tr>", currency, sub.nativeTotal))
+			continue
+		}
+		panel.WriteString(fmt.Sprintf("<tr><td>%s</td><td>%.2f</td><td>%.6f</td><td>%.2f</td></tr>",
+			currency, sub.nativeTotal, sub.rate, sub.converted))
+	}
+	panel.WriteString("</tbody></table>")
+
+	panel.WriteString(fmt.Sprintf("<p><strong>Grand Total Net Worth (%s):</strong> <span class=\"%s\">%.2f</span></p>",
+		baseCurrency, getBalanceClass(grandTotal), grandTotal))
+	panel.WriteString(fmt.Sprintf("<p><em>Rates as of %s</em></p>", asOf.Format("2006-01-02 15:04:05 MST")))
+
+	return panel.String()
+}
@@ -0,0 +1,56 @@
+package report
+
+import (
+	"encoding/csv"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/pranavagiligar/ezbookkeeping_tools/internal/api"
+)
+
+// ExportToCSV generates and saves the CSV file, and optionally prints the
+// same rows to the console.
+func ExportToCSV(filename string, accounts []api.Account, print bool) error {
+	var csvData [][]string
+	csvData = append(csvData, []string{"ID", "Name", "Currency", "Balance", "Category", "IsAsset", "IsLiability", "Comment"})
+
+	for _, acc := range accounts {
+		csvData = append(csvData, []string{
+			acc.ID,
+			acc.Name,
+			acc.Currency,
+			convertBalance(acc.Balance, acc.Currency),
+			api.AccountCategory(acc.Category).String(),
+			fmt.Sprintf("%t", acc.IsAsset),
+			fmt.Sprintf("%t", acc.IsLiability),
+			acc.Comment,
+		})
+	}
+
+	file, err := os.Create(filename)
+	if err != nil {
+		return fmt.Errorf("could not create file %s: %w", filename, err)
+	}
+	defer file.Close()
+
+	writer := csv.NewWriter(file)
+	if err := writer.WriteAll(csvData); err != nil {
+		return fmt.Errorf("error writing data to %s: %w", filename, err)
+	}
+	writer.Flush()
+	fmt.Printf("📝 Successfully wrote %d records to %s\n", len(accounts), filename)
+
+	if print {
+		fmt.Printf("\n--- Console Output: %s ---\n", strings.ToUpper(strings.TrimSuffix(filename, ".csv")))
+		consoleWriter := csv.NewWriter(os.Stdout)
+		consoleWriter.Comma = '\t'
+		if err := consoleWriter.WriteAll(csvData); err != nil {
+			return fmt.Errorf("error printing to console: %w", err)
+		}
+		consoleWriter.Flush()
+		fmt.Println("----------------------------------------------------------------")
+	}
+
+	return nil
+}
@@ -0,0 +1,169 @@
+package report
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/pranavagiligar/ezbookkeeping_tools/internal/api"
+	"github.com/pranavagiligar/ezbookkeeping_tools/internal/fxrates"
+)
+
+// ReportOptions carries the optional extras GenerateHTMLReport can embed
+// alongside the core assets/liabilities tables.
+type ReportOptions struct {
+	// History is prior snapshot data used to render the net-worth trend
+	// panel and the "since last snapshot" column. Nil skips both.
+	History []Snapshot
+	// Now is the time the report is generated as of.
+	Now time.Time
+	// BaseCurrency, if set, adds a "Consolidated Net Worth" panel
+	// converting every account into this currency via FXProvider.
+	BaseCurrency string
+	FXProvider   fxrates.FXProvider
+}
+
+// --- ISO 4217 Currency Exponent Mapping ---
+// Most currencies use an exponent of 2 (e.g., 100 units = 1 major unit).
+// This map stores the exponent to use (e.g., USD: 2 means balance / 10^2).
+// Reference: https://en.wikipedia.org/wiki/ISO_4217
+var currencyExponents = map[string]int{
+	"USD": 2, "EUR": 2, "GBP": 2, "JPY": 0, "CNY": 2, "INR": 2, "CAD": 2, "AUD": 2,
+	"HUF": 2, "JOD": 3, "KWD": 3, "OMR": 3, // Examples of 0, 3-exponent currencies
+}
+
+// GenerateHTMLReport creates a single HTML page with two tables, plus a
+// net-worth trend panel built from any recorded snapshot history and,
+// when opts.BaseCurrency is set, a consolidated multi-currency net worth
+// panel.
+func GenerateHTMLReport(assets, liabilities []api.Account, opts ReportOptions) string {
+	now := opts.Now
+	reportTime := now.Format("2006-01-02 15:04:05 MST")
+
+	assetTotals := calculateTotalBalances(assets)
+	liabilityTotals := calculateTotalBalances(liabilities)
+	var summary strings.Builder
+	summary.WriteString(fmt.Sprintf("<p>Report generated on: <strong>%s</strong></p>", reportTime))
+	summary.WriteString("<h2>Financial Summary</h2>")
+	for currency, total := range assetTotals {
+		liabilityTotal := liabilityTotals[currency]
+		// liabilityTotal are negative. So negate it
+		totalAsset := total - liabilityTotal
+		netAsset := totalAsset + liabilityTotal
+		summary.WriteString(fmt.Sprintf("<p><strong>Total Assets (%s):</strong> <span class=\"positive\">%.2f</span></p>", currency, totalAsset))
+		summary.WriteString(fmt.Sprintf("<p><strong>Total Liabilities (%s):</strong> <span class=\"negative\">%.2f</span></p>", currency, liabilityTotal))
+		summary.WriteString(fmt.Sprintf("<p><strong>Net Assets (%s):</strong> <span class=\"%s\">%.2f</span></p>", currency, getBalanceClass(netAsset), netAsset))
+	}
+
+	htmlTemplate := `
+			<!DOCTYPE html>
+			<html>
+			<head>
+			<style>
+			body { font-family: Arial, sans-serif; }
+			table { width: 80%%; border-collapse: collapse; margin-bottom: 20px; }
+			th, td { border: 1px solid #ddd; padding: 8px; text-align: left; }
+			th { background-color: #f2f2f2; }
+			.positive { color: green; font-weight: bold; }
+			.negative { color: red; font-weight: bold; }
+			</style>
+			</head>
+			<body>
+			<h1>Financial Account Summary</h1>
+			<p>This report contains a summary of your Assets and Liabilities.</p>
+			%s
+			%s
+			%s
+			<h2>Assets</h2>
+			%s
+
+			<h2>Liabilities</h2>
+			%s
+
+			</body>
+			</html>
+			`
+	allAccounts := append(append([]api.Account{}, assets...), liabilities...)
+	sinceLast := sinceLastSnapshot(allAccounts, opts.History, now)
+	trendPanel := generateTrendPanel(opts.History, now)
+	consolidatedPanel := generateConsolidatedPanel(allAccounts, opts.BaseCurrency, opts.FXProvider, now)
+	assetTable := generateHTMLTable(assets, sinceLast)
+	liabilityTable := generateHTMLTable(liabilities, sinceLast)
+
+	return fmt.Sprintf(htmlTemplate, summary.String(), trendPanel, consolidatedPanel, assetTable, liabilityTable)
+}
+
+// calculateTotalBalances sums the balances of accounts, grouped by currency, and returns them in major units.
+func calculateTotalBalances(accounts []api.Account) map[string]float64 {
+	totals := make(map[string]float64)
+	for _, acc := range accounts {
+		totals[acc.Currency] += convertBalanceValue(acc.Balance, acc.Currency)
+	}
+	return totals
+}
+
+func getBalanceClass(balance float64) string {
+	if balance >= 0 {
+		return "positive"
+	}
+	return "negative"
+}
+
+// generateHTMLTable is a helper function to create the HTML table structure.
+// sinceLast supplies the "since last snapshot" column; accounts with no
+// prior snapshot render that column as "—".
+func generateHTMLTable(accounts []api.Account, sinceLast map[string]accountSinceLast) string {
+	if len(accounts) == 0 {
+		return "<p>No accounts found in this category.</p>"
+	}
+
+	var table strings.Builder
+	table.WriteString("<table><thead><tr><th>Name</th><th>Currency</th><th>Balance</th><th>Category</th><th>Since Last Snapshot</th><th>Comment</th></tr></thead><tbody>")
+
+	for _, acc := range accounts {
+		formattedBalance := convertBalance(acc.Balance, acc.Currency)
+		balanceClass := "positive"
+		if acc.IsLiability {
+			balanceClass = "negative"
+		}
+
+		sinceLastCell := "—"
+		if change, ok := sinceLast[acc.ID]; ok && change.HasPrior {
+			sinceLastCell = fmt.Sprintf("<span class=\"%s\">%+.2f</span>", getBalanceClass(change.Delta), change.Delta)
+		}
+
+		table.WriteString(fmt.Sprintf("<tr><td>%s</td><td>%s</td><td class=\"%s\">%s</td><td>%s</td><td>%s</td><td>%s</td></tr>",
+			acc.Name,
+			acc.Currency,
+			balanceClass,
+			formattedBalance,
+			api.AccountCategory(acc.Category).String(),
+			sinceLastCell,
+			acc.Comment,
+		))
+	}
+
+	table.WriteString("</tbody></table>")
+	return table.String()
+}
+
+// convertBalance adjusts the balance from minor units (e.g., cents) to major units (e.g., dollars).
+func convertBalance(balance float64, currency string) string {
+	exp := currencyExponent(currency)
+	return fmt.Sprintf("%.*f", exp, balance/math.Pow(10, float64(exp)))
+}
+
+// convertBalanceValue is the numeric counterpart to convertBalance, used
+// where a float rather than a formatted string is needed.
+func convertBalanceValue(balance float64, currency string) float64 {
+	exp := currencyExponent(currency)
+	return balance / math.Pow(10, float64(exp))
+}
+
+func currencyExponent(currency string) int {
+	if exp, ok := currencyExponents[strings.ToUpper(currency)]; ok {
+		return exp
+	}
+	return 2 // Default to 2 if currency exponent is unknown
+}
@@ -0,0 +1,151 @@
+package report
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pranavagiligar/ezbookkeeping_tools/internal/api"
+)
+
+// --- OFX 2.x Export ---
+// MakeOFX builds a minimal but valid OFX 2.x SGML/XML statement document
+// for the given accounts. One STMTRS is emitted per asset/checking-style
+// account and one CCSTMTRS per credit card. Accounts whose category does
+// not map to a known OFX ACCTTYPE are skipped.
+//
+// Transactions are not fetched by this tool yet, so BANKTRANLIST /
+// CCSTMTTRNRS transaction lists are always emitted empty; LEDGERBAL and
+// AVAILBAL are still populated from the account balance so the resulting
+// statement is valid OFX and importable by desktop finance tools.
+func MakeOFX(accounts []api.Account) ([]byte, error) {
+	now := time.Now()
+	dtserver := now.Format("20060102150405")
+	trnuid := fmt.Sprintf("%d", now.UnixNano())
+
+	var body strings.Builder
+	body.WriteString(`<?xml version="1.0" encoding="UTF-8"?>` + "\n")
+	body.WriteString(`<?OFX OFXHEADER="200" VERSION="211" SECURITY="NONE" OLDFILEUID="NONE" NEWFILEUID="NONE"?>` + "\n")
+	body.WriteString("<OFX>\n")
+
+	body.WriteString("<SIGNONMSGSRSV1>\n<SONRS>\n")
+	body.WriteString("<STATUS><CODE>0</CODE><SEVERITY>INFO</SEVERITY></STATUS>\n")
+	body.WriteString(fmt.Sprintf("<DTSERVER>%s</DTSERVER>\n", dtserver))
+	body.WriteString("<LANGUAGE>ENG</LANGUAGE>\n")
+	body.WriteString("<FI><ORG>ezbookkeeping</ORG></FI>\n")
+	body.WriteString("</SONRS>\n</SIGNONMSGSRSV1>\n")
+
+	body.WriteString("<BANKMSGSRSV1>\n")
+	for _, acc := range accounts {
+		acctType, ok := ofxAcctType(api.AccountCategory(acc.Category))
+		if !ok || acctType == "CREDITCARD" {
+			continue
+		}
+		body.WriteString(ofxBankStmt(acc, acctType, trnuid, dtserver))
+	}
+	body.WriteString("</BANKMSGSRSV1>\n")
+
+	body.WriteString("<CREDITCARDMSGSRSV1>\n")
+	for _, acc := range accounts {
+		acctType, ok := ofxAcctType(api.AccountCategory(acc.Category))
+		if !ok || acctType != "CREDITCARD" {
+			continue
+		}
+		body.WriteString(ofxCCStmt(acc, trnuid, dtserver))
+	}
+	body.WriteString("</CREDITCARDMSGSRSV1>\n")
+
+	body.WriteString("</OFX>\n")
+	return []byte(body.String()), nil
+}
+
+// ofxAcctType maps an ezbookkeeping AccountCategory to the corresponding
+// OFX ACCTTYPE. The bool result is false when the category has no
+// sensible OFX equivalent (e.g. VirtualAccount, Receivables).
+func ofxAcctType(category api.AccountCategory) (string, bool) {
+	switch category {
+	case api.CheckingAccount:
+		return "CHECKING", true
+	case api.SavingsAccount:
+		return "SAVINGS", true
+	case api.CreditCard:
+		return "CREDITCARD", true
+	case api.InvestmentAccount:
+		return "INVSTMT", true
+	default:
+		return "", false
+	}
+}
+
+func ofxBankStmt(acc api.Account, acctType, trnuid, dtserver string) string {
+	balance := convertBalance(acc.Balance, acc.Currency)
+	var s strings.Builder
+	s.WriteString("<STMTTRNRS>\n")
+	s.WriteString(fmt.Sprintf("<TRNUID>%s</TRNUID>\n", trnuid))
+	s.WriteString("<STATUS><CODE>0</CODE><SEVERITY>INFO</SEVERITY></STATUS>\n")
+	s.WriteString("<STMTRS>\n")
+	s.WriteString(fmt.Sprintf("<CURDEF>%s</CURDEF>\n", strings.ToUpper(acc.Currency)))
+	s.WriteString("<BANKACCTFROM>\n")
+	s.WriteString("<BANKID>EZBOOKKEEPING</BANKID>\n")
+	s.WriteString(fmt.Sprintf("<ACCTID>%s</ACCTID>\n", ofxEscape(acc.ID)))
+	s.WriteString(fmt.Sprintf("<ACCTTYPE>%s</ACCTTYPE>\n", acctType))
+	s.WriteString("</BANKACCTFROM>\n")
+	s.WriteString("<BANKTRANLIST>\n")
+	s.WriteString(fmt.Sprintf("<DTSTART>%s</DTSTART>\n", dtserver))
+	s.WriteString(fmt.Sprintf("<DTEND>%s</DTEND>\n", dtserver))
+	s.WriteString("</BANKTRANLIST>\n")
+	s.WriteString("<LEDGERBAL>\n")
+	s.WriteString(fmt.Sprintf("<BALAMT>%s</BALAMT>\n", balance))
+	s.WriteString(fmt.Sprintf("<DTASOF>%s</DTASOF>\n", dtserver))
+	s.WriteString("</LEDGERBAL>\n")
+	s.WriteString("<AVAILBAL>\n")
+	s.WriteString(fmt.Sprintf("<BALAMT>%s</BALAMT>\n", balance))
+	s.WriteString(fmt.Sprintf("<DTASOF>%s</DTASOF>\n", dtserver))
+	s.WriteString("</AVAILBAL>\n")
+	s.WriteString("</STMTRS>\n</STMTTRNRS>\n")
+	return s.String()
+}
+
+func ofxCCStmt(acc api.Account, trnuid, dtserver string) string {
+	balance := convertBalance(acc.Balance, acc.Currency)
+	var s strings.Builder
+	s.WriteString("<CCSTMTTRNRS>\n")
+	s.WriteString(fmt.Sprintf("<TRNUID>%s</TRNUID>\n", trnuid))
+	s.WriteString("<STATUS><CODE>0</CODE><SEVERITY>INFO</SEVERITY></STATUS>\n")
+	s.WriteString("<CCSTMTRS>\n")
+	s.WriteString(fmt.Sprintf("<CURDEF>%s</CURDEF>\n", strings.ToUpper(acc.Currency)))
+	s.WriteString("<CCACCTFROM>\n")
+	s.WriteString(fmt.Sprintf("<ACCTID>%s</ACCTID>\n", ofxEscape(acc.ID)))
+	s.WriteString("</CCACCTFROM>\n")
+	s.WriteString("<BANKTRANLIST>\n")
+	s.WriteString(fmt.Sprintf("<DTSTART>%s</DTSTART>\n", dtserver))
+	s.WriteString(fmt.Sprintf("<DTEND>%s</DTEND>\n", dtserver))
+	s.WriteString("</BANKTRANLIST>\n")
+	s.WriteString("<LEDGERBAL>\n")
+	s.WriteString(fmt.Sprintf("<BALAMT>%s</BALAMT>\n", balance))
+	s.WriteString(fmt.Sprintf("<DTASOF>%s</DTASOF>\n", dtserver))
+	s.WriteString("</LEDGERBAL>\n")
+	s.WriteString("</CCSTMTRS>\n</CCSTMTTRNRS>\n")
+	return s.String()
+}
+
+// ofxEscape escapes the handful of characters that are not safe to embed
+// directly in OFX SGML/XML element content.
+func ofxEscape(s string) string {
+	replacer := strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+	return replacer.Replace(s)
+}
+
+// ExportToOFX renders accounts as an OFX 2.x document and writes it to
+// filename.
+func ExportToOFX(filename string, accounts []api.Account) error {
+	data, err := MakeOFX(accounts)
+	if err != nil {
+		return fmt.Errorf("error building OFX document: %w", err)
+	}
+	if err := writeFile(filename, data); err != nil {
+		return fmt.Errorf("could not write file %s: %w", filename, err)
+	}
+	fmt.Printf("📝 Successfully wrote OFX statement to %s\n", filename)
+	return nil
+}
@@ -0,0 +1,38 @@
+package report
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/pranavagiligar/ezbookkeeping_tools/internal/api"
+)
+
+func TestMakeOFXSeparatesBankAndCreditCardAccounts(t *testing.T) {
+	accounts := []api.Account{
+		{ID: "chk-1", Currency: "USD", Balance: 10000, Category: int(api.CheckingAccount)},
+		{ID: "cc-1", Currency: "USD", Balance: -5000, Category: int(api.CreditCard)},
+		{ID: "virt-1", Currency: "USD", Balance: 100, Category: int(api.VirtualAccount)},
+	}
+
+	doc, err := MakeOFX(accounts)
+	if err != nil {
+		t.Fatalf("MakeOFX() error = %v", err)
+	}
+
+	got := string(doc)
+	if !strings.Contains(got, "<ACCTID>chk-1</ACCTID>") || !strings.Contains(got, "<ACCTTYPE>CHECKING</ACCTTYPE>") {
+		t.Error("expected checking account to appear in BANKMSGSRSV1 as CHECKING")
+	}
+	if !strings.Contains(got, "<ACCTID>cc-1</ACCTID>") {
+		t.Error("expected credit card account to appear under CCSTMTRS")
+	}
+	if strings.Contains(got, "virt-1") {
+		t.Error("expected account with no OFX ACCTTYPE mapping to be skipped")
+	}
+}
+
+func TestOfxEscape(t *testing.T) {
+	if got := ofxEscape("A&B<C>"); got != "A&amp;B&lt;C&gt;" {
+		t.Errorf("ofxEscape() = %q", got)
+	}
+}
@@ -0,0 +1,230 @@
+package report
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	_ "github.com/lib/pq"
+	_ "github.com/mattn/go-sqlite3"
+
+	"github.com/pranavagiligar/ezbookkeeping_tools/internal/api"
+)
+
+// --- Periodic Balance Snapshots ---
+// Snapshot is a single point-in-time balance observation for one account,
+// keyed by (Timestamp, AccountID, Currency) so repeated runs on the same
+// account simply add another row to its time series.
+type Snapshot struct {
+	Timestamp      time.Time
+	AccountID      string
+	Currency       string
+	BalanceMinor   float64
+	MajorUnitValue float64
+	IsAsset        bool
+	IsLiability    bool
+}
+
+// snapshotBatchSize caps how many points are buffered before WriteBatch
+// forces a flush, mirroring the bounded-buffer-then-single-INSERT pattern
+// used by the RethinkDB writer this package was modeled on.
+const snapshotBatchSize = 500
+
+// SnapshotStore persists account balance snapshots to a time-series
+// backend. Implementations must make WriteBatch safe to call repeatedly
+// across runs of this tool.
+type SnapshotStore interface {
+	// WriteBatch writes points in as few round-trips as possible. It must
+	// either write every point or return an error; it never partially
+	// commits a batch.
+	WriteBatch(points []Snapshot) error
+	// Query returns every snapshot recorded at or after since, ordered by
+	// timestamp ascending.
+	Query(since time.Time) ([]Snapshot, error)
+	Close() error
+}
+
+// sqlSnapshotStore implements SnapshotStore on top of database/sql and
+// works for both the sqlite and Postgres backends: the two only differ in
+// driver name, DSN, and placeholder style.
+type sqlSnapshotStore struct {
+	db             *sql.DB
+	driverName     string
+	dsn            string
+	placeholderFmt func(n int) string
+}
+
+// NewSQLiteSnapshotStore opens (creating if necessary) a sqlite database
+// at path and ensures the snapshots table exists.
+func NewSQLiteSnapshotStore(path string) (SnapshotStore, error) {
+	store := &sqlSnapshotStore{
+		driverName:     "sqlite3",
+		dsn:            path,
+		placeholderFmt: func(n int) string { return "?" },
+	}
+	if err := store.connect(); err != nil {
+		return nil, err
+	}
+	return store, nil
+}
+
+// NewSQLSnapshotStore opens a generic SQL backend (e.g. Postgres) using
+// the given driver name and DSN.
+func NewSQLSnapshotStore(driverName, dsn string) (SnapshotStore, error) {
+	store := &sqlSnapshotStore{
+		driverName: driverName,
+		dsn:        dsn,
+		placeholderFmt: func(n int) string {
+			return fmt.Sprintf("$%d", n)
+		},
+	}
+	if err := store.connect(); err != nil {
+		return nil, err
+	}
+	return store, nil
+}
+
+func (s *sqlSnapshotStore) connect() error {
+	db, err := sql.Open(s.driverName, s.dsn)
+	if err != nil {
+		return fmt.Errorf("error opening snapshot store (%s): %w", s.driverName, err)
+	}
+	if err := db.Ping(); err != nil {
+		return fmt.Errorf("error connecting to snapshot store (%s): %w", s.driverName, err)
+	}
+	if _, err := db.Exec(`
+		CREATE TABLE IF NOT EXISTS snapshots (
+			timestamp        INTEGER NOT NULL,
+			account_id       TEXT NOT NULL,
+			currency         TEXT NOT NULL,
+			balance_minor    REAL NOT NULL,
+			major_unit_value REAL NOT NULL,
+			is_asset         BOOLEAN NOT NULL,
+			is_liability     BOOLEAN NOT NULL
+		)`); err != nil {
+		return fmt.Errorf("error creating snapshots table: %w", err)
+	}
+	s.db = db
+	return nil
+}
+
+// WriteBatch writes points to the snapshots table in chunks of at most
+// snapshotBatchSize, each chunk as a single multi-row INSERT inside its
+// own transaction. If the underlying connection has dropped (e.g. the
+// sqlite file was moved, or Postgres recycled the connection), it
+// reconnects once and retries before giving up.
+func (s *sqlSnapshotStore) WriteBatch(points []Snapshot) error {
+	for start := 0; start < len(points); start += snapshotBatchSize {
+		end := start + snapshotBatchSize
+		if end > len(points) {
+			end = len(points)
+		}
+		if err := s.writeChunk(points[start:end]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *sqlSnapshotStore) writeChunk(points []Snapshot) error {
+	err := s.insertChunk(points)
+	if err == nil {
+		return nil
+	}
+	// Panic-safe reconnect: a closed/broken connection is the one failure
+	// mode worth retrying automatically; anything else is a real error.
+	if pingErr := s.db.Ping(); pingErr != nil {
+		if reconnErr := s.connect(); reconnErr != nil {
+			return fmt.Errorf("error writing snapshot batch and reconnecting: %w (original: %v)", reconnErr, err)
+		}
+		return s.insertChunk(points)
+	}
+	return err
+}
+
+func (s *sqlSnapshotStore) insertChunk(points []Snapshot) error {
+	if len(points) == 0 {
+		return nil
+	}
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf("error starting snapshot transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var sb strings.Builder
+	sb.WriteString("INSERT INTO snapshots (timestamp, account_id, currency, balance_minor, major_unit_value, is_asset, is_liability) VALUES ")
+	args := make([]interface{}, 0, len(points)*7)
+	for i, p := range points {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		base := i * 7
+		sb.WriteString(fmt.Sprintf("(%s, %s, %s, %s, %s, %s, %s)",
+			s.placeholderFmt(base+1), s.placeholderFmt(base+2), s.placeholderFmt(base+3),
+			s.placeholderFmt(base+4), s.placeholderFmt(base+5), s.placeholderFmt(base+6), s.placeholderFmt(base+7)))
+		args = append(args, p.Timestamp.Unix(), p.AccountID, p.Currency, p.BalanceMinor, p.MajorUnitValue, p.IsAsset, p.IsLiability)
+	}
+
+	if _, err := tx.Exec(sb.String(), args...); err != nil {
+		return fmt.Errorf("error inserting snapshot batch: %w", err)
+	}
+	return tx.Commit()
+}
+
+// Query returns every snapshot recorded at or after since, ordered by
+// timestamp ascending.
+func (s *sqlSnapshotStore) Query(since time.Time) ([]Snapshot, error) {
+	rows, err := s.db.Query(fmt.Sprintf(
+		"SELECT timestamp, account_id, currency, balance_minor, major_unit_value, is_asset, is_liability FROM snapshots WHERE timestamp >= %s ORDER BY timestamp ASC",
+		s.placeholderFmt(1)), since.Unix())
+	if err != nil {
+		return nil, fmt.Errorf("error querying snapshots: %w", err)
+	}
+	defer rows.Close()
+
+	var points []Snapshot
+	for rows.Next() {
+		var p Snapshot
+		var ts int64
+		if err := rows.Scan(&ts, &p.AccountID, &p.Currency, &p.BalanceMinor, &p.MajorUnitValue, &p.IsAsset, &p.IsLiability); err != nil {
+			return nil, fmt.Errorf("error scanning snapshot row: %w", err)
+		}
+		p.Timestamp = time.Unix(ts, 0)
+		points = append(points, p)
+	}
+	return points, rows.Err()
+}
+
+func (s *sqlSnapshotStore) Close() error {
+	return s.db.Close()
+}
+
+// OpenSnapshotStore builds the configured SnapshotStore, defaulting to
+// sqlite at dbPath when dsn is empty.
+func OpenSnapshotStore(dbPath, driverName, dsn string) (SnapshotStore, error) {
+	if dsn != "" {
+		return NewSQLSnapshotStore(driverName, dsn)
+	}
+	return NewSQLiteSnapshotStore(dbPath)
+}
+
+// SnapshotsFromAccounts converts a fetched account list into the
+// Snapshot rows written for this run.
+func SnapshotsFromAccounts(accounts []api.Account, at time.Time) []Snapshot {
+	points := make([]Snapshot, 0, len(accounts))
+	for _, acc := range accounts {
+		points = append(points, Snapshot{
+			Timestamp:      at,
+			AccountID:      acc.ID,
+			Currency:       acc.Currency,
+			BalanceMinor:   acc.Balance,
+			MajorUnitValue: convertBalanceValue(acc.Balance, acc.Currency),
+			IsAsset:        acc.IsAsset,
+			IsLiability:    acc.IsLiability,
+		})
+	}
+	return points
+}
@@ -0,0 +1,206 @@
+package report
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pranavagiligar/ezbookkeeping_tools/internal/api"
+)
+
+// trendWindows are the lookback windows shown in the emailed report.
+var trendWindows = []struct {
+	label string
+	days  int
+}{
+	{"30d", 30},
+	{"90d", 90},
+	{"365d", 365},
+}
+
+// currencyTrend summarizes how a currency's net worth moved over a
+// lookback window.
+type currencyTrend struct {
+	Currency    string
+	WindowLabel string
+	StartNet    float64
+	EndNet      float64
+	DeltaNet    float64
+}
+
+// accountSinceLast holds the change in an account's balance since the
+// most recent prior snapshot, for the "since last snapshot" report column.
+type accountSinceLast struct {
+	AccountID string
+	Delta     float64
+	HasPrior  bool
+}
+
+// computeCurrencyTrends groups points by currency and, for each
+// configured lookback window, reports the net-worth delta between the
+// oldest and newest snapshot inside that window.
+func computeCurrencyTrends(points []Snapshot, now time.Time) []currencyTrend {
+	byCurrency := make(map[string][]Snapshot)
+	for _, p := range points {
+		byCurrency[p.Currency] = append(byCurrency[p.Currency], p)
+	}
+
+	var trends []currencyTrend
+	for currency, pts := range byCurrency {
+		sort.Slice(pts, func(i, j int) bool { return pts[i].Timestamp.Before(pts[j].Timestamp) })
+		for _, w := range trendWindows {
+			cutoff := now.AddDate(0, 0, -w.days)
+			var inWindow []Snapshot
+			for _, p := range pts {
+				if !p.Timestamp.Before(cutoff) {
+					inWindow = append(inWindow, p)
+				}
+			}
+			if len(inWindow) == 0 {
+				continue
+			}
+			startNet := netWorthAt(inWindow[0].Timestamp, pts)
+			endNet := netWorthAt(inWindow[len(inWindow)-1].Timestamp, pts)
+			trends = append(trends, currencyTrend{
+				Currency:    currency,
+				WindowLabel: w.label,
+				StartNet:    startNet,
+				EndNet:      endNet,
+				DeltaNet:    endNet - startNet,
+			})
+		}
+	}
+
+	sort.Slice(trends, func(i, j int) bool {
+		if trends[i].Currency != trends[j].Currency {
+			return trends[i].Currency < trends[j].Currency
+		}
+		return trends[i].WindowLabel < trends[j].WindowLabel
+	})
+	return trends
+}
+
+// netWorthAt sums every point in pts recorded at exactly timestamp,
+// treating liabilities as negative contributions to net worth.
+func netWorthAt(timestamp time.Time, pts []Snapshot) float64 {
+	var net float64
+	for _, p := range pts {
+		if !p.Timestamp.Equal(timestamp) {
+			continue
+		}
+		if p.IsLiability {
+			net -= p.MajorUnitValue
+		} else {
+			net += p.MajorUnitValue
+		}
+	}
+	return net
+}
+
+// sinceLastSnapshot returns, for each account present in accounts, the
+// change in major-unit balance since the previous recorded snapshot (if
+// any exists before `now`).
+func sinceLastSnapshot(accounts []api.Account, points []Snapshot, now time.Time) map[string]accountSinceLast {
+	latestBefore := make(map[string]Snapshot)
+	for _, p := range points {
+		if !p.Timestamp.Before(now) {
+			continue
+		}
+		if existing, ok := latestBefore[p.AccountID]; !ok || p.Timestamp.After(existing.Timestamp) {
+			latestBefore[p.AccountID] = p
+		}
+	}
+
+	result := make(map[string]accountSinceLast, len(accounts))
+	for _, acc := range accounts {
+		prior, ok := latestBefore[acc.ID]
+		if !ok {
+			result[acc.ID] = accountSinceLast{AccountID: acc.ID}
+			continue
+		}
+		result[acc.ID] = accountSinceLast{
+			AccountID: acc.ID,
+			Delta:     convertBalanceValue(acc.Balance, acc.Currency) - prior.MajorUnitValue,
+			HasPrior:  true,
+		}
+	}
+	return result
+}
+
+// generateTrendPanel renders the net-worth deltas over the configured
+// lookback windows, plus a per-account sparkline, from recorded snapshot
+// history. It returns an empty-history notice when no snapshots exist yet.
+func generateTrendPanel(history []Snapshot, now time.Time) string {
+	if len(history) == 0 {
+		return "<h2>Net Worth Trend</h2><p>No snapshot history recorded yet.</p>"
+	}
+
+	var panel strings.Builder
+	panel.WriteString("<h2>Net Worth Trend</h2>")
+	panel.WriteString("<table><thead><tr><th>Currency</th><th>Window</th><th>Net Worth Change</th></tr></thead><tbody>")
+	for _, t := range computeCurrencyTrends(history, now) {
+		panel.WriteString(fmt.Sprintf("<tr><td>%s</td><td>%s</td><td class=\"%s\">%.2f</td></tr>",
+			t.Currency, t.WindowLabel, getBalanceClass(t.DeltaNet), t.DeltaNet))
+	}
+	panel.WriteString("</tbody></table>")
+
+	byAccount := make(map[string][]float64)
+	var order []string
+	for _, p := range history {
+		if _, seen := byAccount[p.AccountID]; !seen {
+			order = append(order, p.AccountID)
+		}
+		byAccount[p.AccountID] = append(byAccount[p.AccountID], p.MajorUnitValue)
+	}
+	panel.WriteString("<h3>Balance Sparklines</h3><table><thead><tr><th>Account</th><th>Trend</th></tr></thead><tbody>")
+	for _, id := range order {
+		panel.WriteString(fmt.Sprintf("<tr><td>%s</td><td>%s</td></tr>", id, renderSparklineSVG(byAccount[id], 120, 24)))
+	}
+	panel.WriteString("</tbody></table>")
+
+	return panel.String()
+}
+
+// renderSparklineSVG draws a minimal inline SVG sparkline from values, with
+// no external JS or CDN dependency so it survives being embedded directly
+// in an HTML email body.
+func renderSparklineSVG(values []float64, width, height int) string {
+	if len(values) < 2 {
+		return "<span>(not enough history yet)</span>"
+	}
+
+	min, max := values[0], values[0]
+	for _, v := range values {
+		if v < min {
+			min = v
+		}
+		if v > max {
+			max = v
+		}
+	}
+	rng := max - min
+	if rng == 0 {
+		rng = 1
+	}
+
+	var points strings.Builder
+	step := float64(width) / float64(len(values)-1)
+	for i, v := range values {
+		x := float64(i) * step
+		y := float64(height) - ((v-min)/rng)*float64(height)
+		if i > 0 {
+			points.WriteString(" ")
+		}
+		points.WriteString(fmt.Sprintf("%.1f,%.1f", x, y))
+	}
+
+	color := "#2a7"
+	if values[len(values)-1] < values[0] {
+		color = "#c33"
+	}
+
+	return fmt.Sprintf(
+		`<svg width="%d" height="%d" viewBox="0 0 %d %d" xmlns="http://www.w3.org/2000/svg"><polyline fill="none" stroke="%s" stroke-width="1.5" points="%s"/></svg>`,
+		width, height, width, height, color, points.String())
+}
@@ -0,0 +1,85 @@
+package report
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pranavagiligar/ezbookkeeping_tools/internal/api"
+)
+
+func TestComputeCurrencyTrends(t *testing.T) {
+	now := time.Date(2026, 7, 26, 12, 0, 0, 0, time.UTC)
+	points := []Snapshot{
+		{Timestamp: now.AddDate(0, 0, -400), Currency: "USD", MajorUnitValue: 1000, IsAsset: true},
+		{Timestamp: now.AddDate(0, 0, -100), Currency: "USD", MajorUnitValue: 1100, IsAsset: true},
+		{Timestamp: now.AddDate(0, 0, -20), Currency: "USD", MajorUnitValue: 1200, IsAsset: true},
+		{Timestamp: now, Currency: "USD", MajorUnitValue: 1300, IsAsset: true},
+	}
+
+	byWindow := make(map[string]currencyTrend)
+	for _, tr := range computeCurrencyTrends(points, now) {
+		byWindow[tr.WindowLabel] = tr
+	}
+
+	cases := []struct {
+		window           string
+		startNet, endNet float64
+	}{
+		{"30d", 1200, 1300},
+		{"90d", 1200, 1300},
+		{"365d", 1100, 1300},
+	}
+	for _, c := range cases {
+		got, ok := byWindow[c.window]
+		if !ok {
+			t.Errorf("expected a %s trend, got none", c.window)
+			continue
+		}
+		if got.StartNet != c.startNet || got.EndNet != c.endNet || got.DeltaNet != c.endNet-c.startNet {
+			t.Errorf("%s trend = %+v, want start=%v end=%v", c.window, got, c.startNet, c.endNet)
+		}
+	}
+}
+
+func TestNetWorthAtCombinesAssetsAndLiabilities(t *testing.T) {
+	ts := time.Now()
+	pts := []Snapshot{
+		{Timestamp: ts, MajorUnitValue: 500, IsAsset: true},
+		{Timestamp: ts, MajorUnitValue: 200, IsLiability: true},
+	}
+	if got := netWorthAt(ts, pts); got != 300 {
+		t.Errorf("netWorthAt() = %v, want 300", got)
+	}
+}
+
+func TestSinceLastSnapshot(t *testing.T) {
+	now := time.Now()
+	accounts := []api.Account{
+		{ID: "acc-1", Currency: "USD", Balance: 15000},
+		{ID: "acc-2", Currency: "USD", Balance: 5000},
+	}
+	points := []Snapshot{
+		{Timestamp: now.Add(-24 * time.Hour), AccountID: "acc-1", MajorUnitValue: 100},
+		{Timestamp: now.Add(-time.Hour), AccountID: "acc-1", MajorUnitValue: 120},
+		{Timestamp: now.Add(time.Hour), AccountID: "acc-1", MajorUnitValue: 999}, // after `now`, must be ignored
+	}
+
+	result := sinceLastSnapshot(accounts, points, now)
+
+	got1, ok := result["acc-1"]
+	if !ok || !got1.HasPrior {
+		t.Fatalf("expected acc-1 to have a prior snapshot, got %+v (ok=%v)", got1, ok)
+	}
+	wantDelta := convertBalanceValue(15000, "USD") - 120
+	if got1.Delta != wantDelta {
+		t.Errorf("acc-1 delta = %v, want %v", got1.Delta, wantDelta)
+	}
+
+	got2, ok := result["acc-2"]
+	if !ok {
+		t.Fatalf("expected acc-2 to be present in the result")
+	}
+	if got2.HasPrior {
+		t.Error("expected acc-2 to have no prior snapshot")
+	}
+}
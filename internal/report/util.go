@@ -0,0 +1,13 @@
+package report
+
+import "os"
+
+// writeFile writes data to filename, truncating any existing file.
+func writeFile(filename string, data []byte) error {
+	return os.WriteFile(filename, data, 0644)
+}
+
+// WriteHTML writes rendered HTML report content to filename.
+func WriteHTML(filename, content string) error {
+	return writeFile(filename, []byte(content))
+}
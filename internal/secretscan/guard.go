@@ -0,0 +1,103 @@
+package secretscan
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// Finding records that cfg value key was discovered inside a git-tracked
+// file.
+type Finding struct {
+	Key  string
+	File string
+}
+
+func (f Finding) String() string {
+	return fmt.Sprintf("%s is committed in %s", f.Key, f.File)
+}
+
+// minValueLength guards against matching on trivially short values
+// (empty strings, "587", "true", ...) that would produce false positives
+// against nearly every tracked file.
+const minValueLength = 8
+
+// GuardCommittedSecrets scans every git-tracked file under dir for the
+// literal value of each entry in values that either looks like a secret
+// (per LooksLikeSecret) or whose key hints that it is one (password,
+// token, secret, key). It returns one Finding per (key, file) match.
+//
+// dir need not be a git repository; if `git ls-files` fails (not a
+// repo, git not installed) GuardCommittedSecrets treats that as "nothing
+// tracked" rather than an error, since a non-git checkout can't have
+// committed anything.
+func GuardCommittedSecrets(dir string, values map[string]string) ([]Finding, error) {
+	trackedFiles, err := gitTrackedFiles(dir)
+	if err != nil || len(trackedFiles) == 0 {
+		return nil, nil
+	}
+
+	candidates := make(map[string]string)
+	for key, value := range values {
+		if len(value) < minValueLength {
+			continue
+		}
+		if _, looksSecret := LooksLikeSecret(value); looksSecret || isSensitiveKey(key) {
+			candidates[key] = value
+		}
+	}
+	if len(candidates) == 0 {
+		return nil, nil
+	}
+
+	var findings []Finding
+	for _, file := range trackedFiles {
+		content, err := os.ReadFile(filepath.Join(dir, file))
+		if err != nil {
+			continue
+		}
+		for key, value := range candidates {
+			if bytes.Contains(content, []byte(value)) {
+				findings = append(findings, Finding{Key: key, File: file})
+			}
+		}
+	}
+
+	sort.Slice(findings, func(i, j int) bool {
+		if findings[i].Key != findings[j].Key {
+			return findings[i].Key < findings[j].Key
+		}
+		return findings[i].File < findings[j].File
+	})
+	return findings, nil
+}
+
+func isSensitiveKey(key string) bool {
+	lower := strings.ToLower(key)
+	for _, marker := range []string{"password", "pass", "secret", "token", "key"} {
+		if strings.Contains(lower, marker) {
+			return true
+		}
+	}
+	return false
+}
+
+func gitTrackedFiles(dir string) ([]string, error) {
+	cmd := exec.Command("git", "-C", dir, "ls-files")
+	var out bytes.Buffer
+	cmd.Stdout = &out
+	if err := cmd.Run(); err != nil {
+		return nil, err
+	}
+	var files []string
+	for _, line := range strings.Split(out.String(), "\n") {
+		if line != "" {
+			files = append(files, line)
+		}
+	}
+	return files, nil
+}
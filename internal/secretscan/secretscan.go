@@ -0,0 +1,65 @@
+// Package secretscan provides a lightweight, local heuristic for
+// spotting values that look like secrets (API keys, tokens, private
+// keys) and for checking whether such a value has ended up committed to
+// this repository. It is not a substitute for a real secret scanner —
+// just a cheap startup guard against the most common footgun: a
+// plaintext credential that made it into a tracked file.
+package secretscan
+
+import (
+	"math"
+	"regexp"
+)
+
+var (
+	awsKeyPattern = regexp.MustCompile(`AKIA[0-9A-Z]{16}`)
+	jwtPattern    = regexp.MustCompile(`eyJ[A-Za-z0-9_-]+\.[A-Za-z0-9_-]+\.[A-Za-z0-9_-]+`)
+	pemPattern    = regexp.MustCompile(`-----BEGIN [A-Z ]+-----`)
+)
+
+// minEntropyLength is the shortest value we bother running the entropy
+// heuristic against; shorter strings are too noisy to judge reliably.
+const minEntropyLength = 20
+
+// highEntropyThreshold is the Shannon entropy (bits per character) above
+// which a string is treated as "looks random enough to be a secret".
+const highEntropyThreshold = 4.0
+
+// LooksLikeSecret classifies value against a handful of well-known
+// secret shapes plus a generic high-entropy fallback. It returns the
+// matched category and whether any pattern matched.
+func LooksLikeSecret(value string) (category string, ok bool) {
+	switch {
+	case awsKeyPattern.MatchString(value):
+		return "aws-access-key", true
+	case jwtPattern.MatchString(value):
+		return "jwt", true
+	case pemPattern.MatchString(value):
+		return "pem-block", true
+	case len(value) >= minEntropyLength && shannonEntropy(value) >= highEntropyThreshold:
+		return "high-entropy", true
+	default:
+		return "", false
+	}
+}
+
+// shannonEntropy returns the Shannon entropy of s in bits per character.
+func shannonEntropy(s string) float64 {
+	if len(s) == 0 {
+		return 0
+	}
+	var counts [256]int
+	for i := 0; i < len(s); i++ {
+		counts[s[i]]++
+	}
+	entropy := 0.0
+	n := float64(len(s))
+	for _, c := range counts {
+		if c == 0 {
+			continue
+		}
+		p := float64(c) / n
+		entropy -= p * math.Log2(p)
+	}
+	return entropy
+}